@@ -0,0 +1,183 @@
+package farcaster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// IterableReactionsResult represents a paginated list of cast likes
+type IterableReactionsResult struct {
+	Likes  []CastReaction `json:"likes"`
+	Cursor *string        `json:"cursor,omitempty"`
+}
+
+// CastLikesResponse represents the API response for cast likes
+type CastLikesResponse struct {
+	Result struct {
+		Likes []CastReaction `json:"likes"`
+	} `json:"result"`
+	Next *struct {
+		Cursor string `json:"cursor"`
+	} `json:"next,omitempty"`
+}
+
+// DeleteCastLike removes the like of the authenticated user from a cast
+//
+// Parameters:
+//   - castHash: Hash of the liked cast
+//
+// Returns:
+//   - *StatusContent: Status of the delete operation
+//   - error: Any error that occurred
+func (w *Warpcast) DeleteCastLike(castHash string) (*StatusContent, error) {
+	return w.DeleteCastLikeContext(context.Background(), castHash)
+}
+
+// DeleteCastLikeContext is like DeleteCastLike but uses ctx for the request
+func (w *Warpcast) DeleteCastLikeContext(ctx context.Context, castHash string) (*StatusContent, error) {
+	body := CastLikesDeleteRequest{
+		CastHash: castHash,
+	}
+
+	resp, err := w.request(ctx, "DELETE", "cast-likes", nil, body, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete cast like: %w", err)
+	}
+
+	var result StatusResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal delete cast like response: %w", err)
+	}
+
+	return &result.Result, nil
+}
+
+// GetCastLikes retrieves the likes of a given cast, with the reactor and
+// time of each like
+// Parameters:
+//   - castHash: Hash of the cast
+//   - cursor: Cursor to start from (optional)
+//   - limit: Number of likes to retrieve (default 25, max 100)
+//
+// Returns:
+//   - *IterableReactionsResult: A collection of likes
+//   - error: Any error that occurred
+func (w *Warpcast) GetCastLikes(castHash string, cursor *string, limit int) (*IterableReactionsResult, error) {
+	return w.GetCastLikesContext(context.Background(), castHash, cursor, limit)
+}
+
+// GetCastLikesContext is like GetCastLikes but uses ctx for every request and
+// stops paginating once ctx is done
+func (w *Warpcast) GetCastLikesContext(ctx context.Context, castHash string, cursor *string, limit int) (*IterableReactionsResult, error) {
+	if limit <= 0 {
+		limit = 25
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	pages := w.CastLikesIter(ctx, castHash)
+	pages.SetCursor(cursor)
+
+	likes, err := collect(pages, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cast likes: %w", err)
+	}
+
+	return &IterableReactionsResult{
+		Likes:  likes,
+		Cursor: pages.Cursor(),
+	}, nil
+}
+
+// CastLikesIter returns a paginator over every like of a given cast
+//
+// Parameters:
+//   - ctx: Context used for every page request
+//   - castHash: Hash of the cast
+//
+// Returns:
+//   - *Paginator[CastReaction]: A lazy paginator over the likes
+func (w *Warpcast) CastLikesIter(ctx context.Context, castHash string) *Paginator[CastReaction] {
+	return w.likesIter(ctx, "cast-likes", map[string]interface{}{
+		"castHash": castHash,
+	})
+}
+
+// GetUserCastLikes retrieves the casts liked by a user, newest first. Each
+// like carries the liked cast
+// Parameters:
+//   - fid: Farcaster ID of the user
+//   - cursor: Cursor to start from (optional)
+//   - limit: Number of likes to retrieve (default 25, max 100)
+//
+// Returns:
+//   - *IterableReactionsResult: A collection of likes
+//   - error: Any error that occurred
+func (w *Warpcast) GetUserCastLikes(fid int, cursor *string, limit int) (*IterableReactionsResult, error) {
+	return w.GetUserCastLikesContext(context.Background(), fid, cursor, limit)
+}
+
+// GetUserCastLikesContext is like GetUserCastLikes but uses ctx for every
+// request and stops paginating once ctx is done
+func (w *Warpcast) GetUserCastLikesContext(ctx context.Context, fid int, cursor *string, limit int) (*IterableReactionsResult, error) {
+	if limit <= 0 {
+		limit = 25
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	pages := w.UserCastLikesIter(ctx, fid)
+	pages.SetCursor(cursor)
+
+	likes, err := collect(pages, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user cast likes: %w", err)
+	}
+
+	return &IterableReactionsResult{
+		Likes:  likes,
+		Cursor: pages.Cursor(),
+	}, nil
+}
+
+// UserCastLikesIter returns a paginator over every cast like of the user with
+// the given FID
+//
+// Parameters:
+//   - ctx: Context used for every page request
+//   - fid: Farcaster ID of the user
+//
+// Returns:
+//   - *Paginator[CastReaction]: A lazy paginator over the likes
+func (w *Warpcast) UserCastLikesIter(ctx context.Context, fid int) *Paginator[CastReaction] {
+	return w.likesIter(ctx, "user-cast-likes", map[string]interface{}{
+		"fid": fid,
+	})
+}
+
+// likesIter returns a paginator over a likes endpoint queried with params
+func (w *Warpcast) likesIter(ctx context.Context, endpoint string, params map[string]interface{}) *Paginator[CastReaction] {
+	return newPaginator(ctx, func(ctx context.Context, cursor *string, limit int) ([]CastReaction, *string, error) {
+		query := map[string]interface{}{
+			"cursor": cursor,
+			"limit":  limit,
+		}
+		for k, v := range params {
+			query[k] = v
+		}
+
+		var response CastLikesResponse
+		if err := w.get(ctx, endpoint, query, &response); err != nil {
+			return nil, nil, err
+		}
+
+		var next *string
+		if response.Next != nil {
+			next = &response.Next.Cursor
+		}
+		return response.Result.Likes, next, nil
+	}, nil)
+}
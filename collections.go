@@ -0,0 +1,167 @@
+package farcaster
+
+import (
+	"context"
+	"fmt"
+)
+
+// IterableCollectionsResult represents a paginated list of NFT collections
+type IterableCollectionsResult struct {
+	Collections []AssetCollection `json:"collections"`
+	Cursor      *string           `json:"cursor,omitempty"`
+}
+
+// UserCollectionsResponse represents the API response for user collections
+type UserCollectionsResponse struct {
+	Result struct {
+		Collections []AssetCollection `json:"collections"`
+	} `json:"result"`
+	Next *struct {
+		Cursor string `json:"cursor"`
+	} `json:"next,omitempty"`
+}
+
+// CollectionOwnersResponse represents the API response for collection owners
+type CollectionOwnersResponse struct {
+	Result struct {
+		Users []ApiUser `json:"users"`
+	} `json:"result"`
+	Next *struct {
+		Cursor string `json:"cursor"`
+	} `json:"next,omitempty"`
+}
+
+// GetUserCollections retrieves the NFT collections a user holds assets of
+// Parameters:
+//   - ownerFID: Farcaster ID of the user
+//   - cursor: Cursor to start from (optional)
+//   - limit: Number of collections to retrieve (default 25, max 100)
+//
+// Returns:
+//   - *IterableCollectionsResult: A collection of NFT collections
+//   - error: Any error that occurred
+func (w *Warpcast) GetUserCollections(ownerFID int, cursor *string, limit int) (*IterableCollectionsResult, error) {
+	return w.GetUserCollectionsContext(context.Background(), ownerFID, cursor, limit)
+}
+
+// GetUserCollectionsContext is like GetUserCollections but uses ctx for every
+// request and stops paginating once ctx is done
+func (w *Warpcast) GetUserCollectionsContext(ctx context.Context, ownerFID int, cursor *string, limit int) (*IterableCollectionsResult, error) {
+	if limit <= 0 {
+		limit = 25
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	pages := w.UserCollectionsIter(ctx, ownerFID)
+	pages.SetCursor(cursor)
+
+	collections, err := collect(pages, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user collections: %w", err)
+	}
+
+	return &IterableCollectionsResult{
+		Collections: collections,
+		Cursor:      pages.Cursor(),
+	}, nil
+}
+
+// UserCollectionsIter returns a paginator over every NFT collection the user
+// with the given FID holds assets of
+//
+// Parameters:
+//   - ctx: Context used for every page request
+//   - ownerFID: Farcaster ID of the user
+//
+// Returns:
+//   - *Paginator[AssetCollection]: A lazy paginator over the collections
+func (w *Warpcast) UserCollectionsIter(ctx context.Context, ownerFID int) *Paginator[AssetCollection] {
+	return newPaginator(ctx, func(ctx context.Context, cursor *string, limit int) ([]AssetCollection, *string, error) {
+		params := map[string]interface{}{
+			"ownerFid": ownerFID,
+			"cursor":   cursor,
+			"limit":    limit,
+		}
+
+		var response UserCollectionsResponse
+		if err := w.get(ctx, "user-collections", params, &response); err != nil {
+			return nil, nil, err
+		}
+
+		var next *string
+		if response.Next != nil {
+			next = &response.Next.Cursor
+		}
+		return response.Result.Collections, next, nil
+	}, nil)
+}
+
+// GetCollectionOwners retrieves the Farcaster users holding assets of an NFT
+// collection
+// Parameters:
+//   - collectionID: ID of the collection, such as "proof-of-merge"
+//   - cursor: Cursor to start from (optional)
+//   - limit: Number of owners to retrieve (default 25, max 100)
+//
+// Returns:
+//   - *IterableUsersResult: A collection of users
+//   - error: Any error that occurred
+func (w *Warpcast) GetCollectionOwners(collectionID string, cursor *string, limit int) (*IterableUsersResult, error) {
+	return w.GetCollectionOwnersContext(context.Background(), collectionID, cursor, limit)
+}
+
+// GetCollectionOwnersContext is like GetCollectionOwners but uses ctx for
+// every request and stops paginating once ctx is done
+func (w *Warpcast) GetCollectionOwnersContext(ctx context.Context, collectionID string, cursor *string, limit int) (*IterableUsersResult, error) {
+	if limit <= 0 {
+		limit = 25
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	pages := w.CollectionOwnersIter(ctx, collectionID)
+	pages.SetCursor(cursor)
+
+	users, err := collect(pages, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection owners: %w", err)
+	}
+
+	return &IterableUsersResult{
+		Users:  users,
+		Cursor: pages.Cursor(),
+	}, nil
+}
+
+// CollectionOwnersIter returns a paginator over every Farcaster user holding
+// assets of an NFT collection
+//
+// Parameters:
+//   - ctx: Context used for every page request
+//   - collectionID: ID of the collection
+//
+// Returns:
+//   - *Paginator[ApiUser]: A lazy paginator over the owners
+func (w *Warpcast) CollectionOwnersIter(ctx context.Context, collectionID string) *Paginator[ApiUser] {
+	return newPaginator(ctx, func(ctx context.Context, cursor *string, limit int) ([]ApiUser, *string, error) {
+		params := map[string]interface{}{
+			"collectionId": collectionID,
+			"cursor":       cursor,
+			"limit":        limit,
+		}
+
+		var response CollectionOwnersResponse
+		if err := w.get(ctx, "collection-owners", params, &response); err != nil {
+			return nil, nil, err
+		}
+
+		var next *string
+		if response.Next != nil {
+			next = &response.Next.Cursor
+		}
+		return response.Result.Users, next, nil
+	}, nil)
+}
@@ -0,0 +1,119 @@
+package farcaster
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// custodyAuthPrefix is the scheme Warpcast expects in front of the base64
+// encoded custody signature
+const custodyAuthPrefix = "eip191:"
+
+// canonicalAuthPayload returns the canonical JSON payload signed by the
+// custody wallet, with keys sorted and no insignificant whitespace
+func canonicalAuthPayload(authParams *AuthParams) ([]byte, error) {
+	if authParams == nil {
+		return nil, fmt.Errorf("auth params are required")
+	}
+
+	// Maps are marshalled with sorted keys, which gives us canonical JSON
+	params := map[string]int64{
+		"timestamp": authParams.Timestamp,
+	}
+	if authParams.ExpiresAt != 0 {
+		params["expiresAt"] = authParams.ExpiresAt
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"method": "generateToken",
+		"params": params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal auth payload: %w", err)
+	}
+
+	return payload, nil
+}
+
+// generateCustodyAuthHeader generates the custody authorization header
+//
+// The canonical generateToken payload is signed as an EIP-191 personal
+// message by the custody signer and sent as "Bearer eip191:<base64 signature>"
+func (w *Warpcast) generateCustodyAuthHeader(ctx context.Context, authParams *AuthParams) (string, error) {
+	if w.signer == nil {
+		return "", fmt.Errorf("wallet or signer is required for custody auth")
+	}
+
+	payload, err := canonicalAuthPayload(authParams)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := w.signer.SignPersonalMessage(ctx, payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign message: %w", err)
+	}
+	if len(signature) != crypto.SignatureLength {
+		return "", fmt.Errorf("signer returned %d byte signature", len(signature))
+	}
+
+	// Accept signers that return the raw 0/1 recovery id. Work on a copy so
+	// a signer that reuses its buffer is left untouched
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] < 27 {
+		sig[crypto.RecoveryIDOffset] += 27
+	}
+
+	return fmt.Sprintf("Bearer %s%s", custodyAuthPrefix, base64.StdEncoding.EncodeToString(sig)), nil
+}
+
+// RecoverCustodyAddress recovers the custody address that signed a custody
+// authorization header for the given auth params
+//
+// Parameters:
+//   - header: The Authorization header value, with or without the "Bearer " prefix
+//   - authParams: The auth params the header was generated for
+//
+// Returns:
+//   - string: The checksummed address of the signer
+//   - error: Any error that occurred
+func RecoverCustodyAddress(header string, authParams *AuthParams) (string, error) {
+	encoded := strings.TrimPrefix(header, "Bearer ")
+	if !strings.HasPrefix(encoded, custodyAuthPrefix) {
+		return "", fmt.Errorf("custody auth header must start with %q", custodyAuthPrefix)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(encoded, custodyAuthPrefix))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if len(signature) != crypto.SignatureLength {
+		return "", fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+
+	payload, err := canonicalAuthPayload(authParams)
+	if err != nil {
+		return "", err
+	}
+
+	// Undo the personal_sign recovery id offset before recovering
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	publicKey, err := crypto.SigToPub(accounts.TextHash(payload), sig)
+	if err != nil {
+		return "", fmt.Errorf("failed to recover public key: %w", err)
+	}
+
+	return crypto.PubkeyToAddress(*publicKey).Hex(), nil
+}
@@ -0,0 +1,131 @@
+package farcaster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// CastDeleteResult represents the outcome of deleting a single cast
+type CastDeleteResult struct {
+	CastHash string
+	// Err is nil if the cast was deleted
+	Err error
+}
+
+// DeleteCast deletes a cast of the authenticated user
+//
+// Parameters:
+//   - castHash: Hash of the cast to delete
+//
+// Returns:
+//   - *StatusContent: Status of the delete operation
+//   - error: Any error that occurred
+func (w *Warpcast) DeleteCast(castHash string) (*StatusContent, error) {
+	return w.DeleteCastContext(context.Background(), castHash)
+}
+
+// DeleteCastContext is like DeleteCast but uses ctx for the request
+func (w *Warpcast) DeleteCastContext(ctx context.Context, castHash string) (*StatusContent, error) {
+	body := CastsDeleteRequest{
+		CastHash: castHash,
+	}
+
+	resp, err := w.request(ctx, "DELETE", "casts", nil, body, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete cast: %w", err)
+	}
+
+	var result StatusResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal delete cast response: %w", err)
+	}
+
+	return &result.Result, nil
+}
+
+// DeleteThreadCasts deletes every cast of the authenticated user in a thread
+//
+// Replies are deleted before the casts they reply to. A failed deletion does
+// not stop the others; its error is reported in the matching result
+//
+// Parameters:
+//   - threadHash: Hash of the thread
+//
+// Returns:
+//   - []CastDeleteResult: The outcome for each cast of the user in the thread
+//   - error: Any error that occurred while looking up the user or the thread
+func (w *Warpcast) DeleteThreadCasts(threadHash string) ([]CastDeleteResult, error) {
+	return w.DeleteThreadCastsContext(context.Background(), threadHash)
+}
+
+// DeleteThreadCastsContext is like DeleteThreadCasts but uses ctx for every
+// request
+func (w *Warpcast) DeleteThreadCastsContext(ctx context.Context, threadHash string) ([]CastDeleteResult, error) {
+	me, err := w.getMe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := w.GetAllCastsInThreadContext(ctx, threadHash)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]CastDeleteResult, 0)
+	for _, cast := range deletionOrder(thread.Casts, me.FID) {
+		_, err := w.DeleteCastContext(ctx, cast.Hash)
+		results = append(results, CastDeleteResult{
+			CastHash: cast.Hash,
+			Err:      err,
+		})
+	}
+
+	return results, nil
+}
+
+// deletionOrder returns the casts of the user with the given FID ordered so
+// that every reply comes before the cast it replies to. Casts are ordered by
+// their depth in the thread, deepest first, then newest first, without
+// relying on the order the API lists them in
+func deletionOrder(casts []ApiCast, fid int) []ApiCast {
+	inThread := make(map[string]bool, len(casts))
+	for _, cast := range casts {
+		inThread[cast.Hash] = true
+	}
+	parents := make(map[string]string, len(casts))
+	for _, cast := range casts {
+		if cast.ParentHash != nil && inThread[*cast.ParentHash] {
+			parents[cast.Hash] = *cast.ParentHash
+		}
+	}
+
+	depths := make(map[string]int)
+	mine := make([]ApiCast, 0)
+	// Walk backwards so that casts with the same depth and timestamp keep
+	// the latest listed first
+	for i := len(casts) - 1; i >= 0; i-- {
+		cast := casts[i]
+		if cast.Author.FID != fid {
+			continue
+		}
+
+		// The walk is bounded by the number of casts so that a malformed
+		// cycle cannot loop forever
+		depth := 0
+		for parent, ok := parents[cast.Hash]; ok && depth < len(casts); parent, ok = parents[parent] {
+			depth++
+		}
+		depths[cast.Hash] = depth
+		mine = append(mine, cast)
+	}
+
+	sort.SliceStable(mine, func(i, j int) bool {
+		if depths[mine[i].Hash] != depths[mine[j].Hash] {
+			return depths[mine[i].Hash] > depths[mine[j].Hash]
+		}
+		return mine[i].Timestamp > mine[j].Timestamp
+	})
+	return mine
+}
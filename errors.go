@@ -0,0 +1,106 @@
+package farcaster
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// APIError represents an error returned by the Warpcast API
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Method is the HTTP method of the failed request
+	Method string
+	// Path is the API path of the failed request
+	Path string
+	// Messages holds the errors[].message entries of the response body
+	Messages []string
+	// RetryAfter is the delay requested by the Retry-After header, if any
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	msg := http.StatusText(e.StatusCode)
+	if len(e.Messages) > 0 {
+		msg = strings.Join(e.Messages, "; ")
+	}
+	return fmt.Sprintf("API error: %s %s: %d %s", e.Method, e.Path, e.StatusCode, msg)
+}
+
+// errorsResponse represents the error body returned by the API
+type errorsResponse struct {
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// checkResponse returns an *APIError if resp failed or its body carries an
+// errors array, and nil otherwise
+func checkResponse(resp *http.Response, method, path string, body []byte) error {
+	var result errorsResponse
+	hasErrors := false
+	if err := json.Unmarshal(body, &result); err == nil && len(result.Errors) > 0 {
+		hasErrors = true
+	}
+
+	if resp.StatusCode < 400 && !hasErrors {
+		return nil
+	}
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Path:       path,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+	for _, e := range result.Errors {
+		apiErr.Messages = append(apiErr.Messages, e.Message)
+	}
+
+	return apiErr
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as
+// an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// hasStatus reports whether err is an *APIError with the given status code
+func hasStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err is an API error for a missing resource
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsRateLimited reports whether err is an API error caused by rate limiting
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized reports whether err is an API error caused by a missing or
+// invalid access token
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
@@ -0,0 +1,300 @@
+package farcaster
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Extras holds the JSON fields of a response object that have no matching
+// struct field, keyed by name with their original encoding
+//
+// Every response model has an Extras field. Models keep their extras when
+// decoded and write them back when encoded, so fields Warpcast adds later
+// survive a round trip. Round trips are not byte for byte: fields are written
+// in struct order followed by the extras in key order, and a modelled field
+// missing from the input is written with its zero value unless its tag has
+// omitempty
+type Extras map[string]json.RawMessage
+
+// knownFields caches the JSON field names of each model type
+var knownFields sync.Map // map[reflect.Type]map[string]bool
+
+// fieldNames returns the JSON names of the encoded fields of struct type t
+func fieldNames(t reflect.Type) map[string]bool {
+	if names, ok := knownFields.Load(t); ok {
+		return names.(map[string]bool)
+	}
+
+	names := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+
+	knownFields.Store(t, names)
+	return names
+}
+
+// plainTypes caches the method-free copy of each model type
+var plainTypes sync.Map // map[reflect.Type]reflect.Type
+
+// plainType returns a struct type with the fields of t but none of its
+// methods, so encoding/json can handle it without calling back into t
+func plainType(t reflect.Type) reflect.Type {
+	if plain, ok := plainTypes.Load(t); ok {
+		return plain.(reflect.Type)
+	}
+
+	fields := make([]reflect.StructField, t.NumField())
+	for i := range fields {
+		fields[i] = t.Field(i)
+	}
+	plain := reflect.StructOf(fields)
+
+	plainTypes.Store(t, plain)
+	return plain
+}
+
+// unmarshalModel implements UnmarshalJSON for a model type T, keeping the
+// fields T does not know in extras
+func unmarshalModel[T any](data []byte, v *T, extras *Extras) error {
+	t := reflect.TypeFor[T]()
+	plain := reflect.New(plainType(t))
+	plain.Elem().Set(reflect.ValueOf(v).Elem().Convert(plain.Elem().Type()))
+
+	var found Extras
+	if err := unmarshalWithExtras(data, plain.Interface(), &found); err != nil {
+		return err
+	}
+
+	*v = plain.Elem().Convert(t).Interface().(T)
+	*extras = found
+	return nil
+}
+
+// marshalModel implements MarshalJSON for a model type T, writing extras
+// after its fields
+func marshalModel[T any](v T, extras Extras) ([]byte, error) {
+	plain := reflect.ValueOf(v).Convert(plainType(reflect.TypeFor[T]()))
+	return marshalWithExtras(plain.Interface(), extras)
+}
+
+// unmarshalWithExtras decodes data into v, a pointer to a struct without
+// custom JSON methods, and stores every field v does not know in extras
+//
+// encoding/json matches keys to fields case-insensitively, so v is decoded
+// from the keys that exactly match a field only. A key such as "FID" next to
+// "fid" is kept in extras instead of overwriting the field
+func unmarshalWithExtras(data []byte, v interface{}, extras *Extras) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || fields == nil {
+		// Let v report what is wrong with a non-object. null decodes to a
+		// nil map, leaving nothing to keep
+		return json.Unmarshal(data, v)
+	}
+
+	known := fieldNames(reflect.TypeOf(v).Elem())
+	matched := make(map[string]json.RawMessage, len(fields))
+	for name, value := range fields {
+		if known[name] {
+			matched[name] = value
+			delete(fields, name)
+		}
+	}
+
+	exact, err := json.Marshal(matched)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(exact, v); err != nil {
+		return err
+	}
+
+	*extras = nil
+	if len(fields) > 0 {
+		*extras = fields
+	}
+	return nil
+}
+
+// marshalWithExtras encodes v, a struct without custom JSON methods, and
+// appends extras in key order. Extras that clash with a field of v are
+// dropped so the struct field always wins
+func marshalWithExtras(v interface{}, extras Extras) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extras) == 0 {
+		return data, err
+	}
+
+	known := fieldNames(reflect.TypeOf(v))
+	names := make([]string, 0, len(extras))
+	for name := range extras {
+		if !known[name] {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return data, nil
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	for i, name := range names {
+		if i > 0 || len(data) > 2 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value := extras[name]
+		if !json.Valid(value) {
+			return nil, fmt.Errorf("invalid JSON in extra field %q", name)
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (u *ApiUser) UnmarshalJSON(data []byte) error { return unmarshalModel(data, u, &u.Extras) }
+func (u ApiUser) MarshalJSON() ([]byte, error)     { return marshalModel(u, u.Extras) }
+
+func (p *Pfp) UnmarshalJSON(data []byte) error { return unmarshalModel(data, p, &p.Extras) }
+func (p Pfp) MarshalJSON() ([]byte, error)     { return marshalModel(p, p.Extras) }
+
+func (p *Profile) UnmarshalJSON(data []byte) error { return unmarshalModel(data, p, &p.Extras) }
+func (p Profile) MarshalJSON() ([]byte, error)     { return marshalModel(p, p.Extras) }
+
+func (b *Bio) UnmarshalJSON(data []byte) error { return unmarshalModel(data, b, &b.Extras) }
+func (b Bio) MarshalJSON() ([]byte, error)     { return marshalModel(b, b.Extras) }
+
+func (l *Location) UnmarshalJSON(data []byte) error { return unmarshalModel(data, l, &l.Extras) }
+func (l Location) MarshalJSON() ([]byte, error)     { return marshalModel(l, l.Extras) }
+
+func (a *ConnectedAccount) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, a, &a.Extras)
+}
+func (a ConnectedAccount) MarshalJSON() ([]byte, error) { return marshalModel(a, a.Extras) }
+
+func (c *UserViewerContext) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, c, &c.Extras)
+}
+func (c UserViewerContext) MarshalJSON() ([]byte, error) { return marshalModel(c, c.Extras) }
+
+func (c *ApiCast) UnmarshalJSON(data []byte) error { return unmarshalModel(data, c, &c.Extras) }
+func (c ApiCast) MarshalJSON() ([]byte, error)     { return marshalModel(c, c.Extras) }
+
+func (s *ParentSource) UnmarshalJSON(data []byte) error { return unmarshalModel(data, s, &s.Extras) }
+func (s ParentSource) MarshalJSON() ([]byte, error)     { return marshalModel(s, s.Extras) }
+
+func (r *Replies) UnmarshalJSON(data []byte) error { return unmarshalModel(data, r, &r.Extras) }
+func (r Replies) MarshalJSON() ([]byte, error)     { return marshalModel(r, r.Extras) }
+
+func (r *Reactions) UnmarshalJSON(data []byte) error { return unmarshalModel(data, r, &r.Extras) }
+func (r Reactions) MarshalJSON() ([]byte, error)     { return marshalModel(r, r.Extras) }
+
+func (w *Watches) UnmarshalJSON(data []byte) error { return unmarshalModel(data, w, &w.Extras) }
+func (w Watches) MarshalJSON() ([]byte, error)     { return marshalModel(w, w.Extras) }
+
+func (r *Recasts) UnmarshalJSON(data []byte) error { return unmarshalModel(data, r, &r.Extras) }
+func (r Recasts) MarshalJSON() ([]byte, error)     { return marshalModel(r, r.Extras) }
+
+func (r *Recaster) UnmarshalJSON(data []byte) error { return unmarshalModel(data, r, &r.Extras) }
+func (r Recaster) MarshalJSON() ([]byte, error)     { return marshalModel(r, r.Extras) }
+
+func (e *Embeds) UnmarshalJSON(data []byte) error { return unmarshalModel(data, e, &e.Extras) }
+func (e Embeds) MarshalJSON() ([]byte, error)     { return marshalModel(e, e.Extras) }
+
+func (e *ImageEmbed) UnmarshalJSON(data []byte) error { return unmarshalModel(data, e, &e.Extras) }
+func (e ImageEmbed) MarshalJSON() ([]byte, error)     { return marshalModel(e, e.Extras) }
+
+func (e *VideoEmbed) UnmarshalJSON(data []byte) error { return unmarshalModel(data, e, &e.Extras) }
+func (e VideoEmbed) MarshalJSON() ([]byte, error)     { return marshalModel(e, e.Extras) }
+
+func (e *UrlEmbed) UnmarshalJSON(data []byte) error { return unmarshalModel(data, e, &e.Extras) }
+func (e UrlEmbed) MarshalJSON() ([]byte, error)     { return marshalModel(e, e.Extras) }
+
+func (o *OpenGraph) UnmarshalJSON(data []byte) error { return unmarshalModel(data, o, &o.Extras) }
+func (o OpenGraph) MarshalJSON() ([]byte, error)     { return marshalModel(o, o.Extras) }
+
+func (c *Channel) UnmarshalJSON(data []byte) error { return unmarshalModel(data, c, &c.Extras) }
+func (c Channel) MarshalJSON() ([]byte, error)     { return marshalModel(c, c.Extras) }
+
+func (t *Tag) UnmarshalJSON(data []byte) error { return unmarshalModel(data, t, &t.Extras) }
+func (t Tag) MarshalJSON() ([]byte, error)     { return marshalModel(t, t.Extras) }
+
+func (c *CastViewerContext) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, c, &c.Extras)
+}
+func (c CastViewerContext) MarshalJSON() ([]byte, error) { return marshalModel(c, c.Extras) }
+
+func (c *NotificationContent) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, c, &c.Extras)
+}
+func (c NotificationContent) MarshalJSON() ([]byte, error) { return marshalModel(c, c.Extras) }
+
+func (n *MentionNotification) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, n, &n.Extras)
+}
+func (n MentionNotification) MarshalJSON() ([]byte, error) { return marshalModel(n, n.Extras) }
+
+func (n *ReplyNotification) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, n, &n.Extras)
+}
+func (n ReplyNotification) MarshalJSON() ([]byte, error) { return marshalModel(n, n.Extras) }
+
+func (a *Asset) UnmarshalJSON(data []byte) error { return unmarshalModel(data, a, &a.Extras) }
+func (a Asset) MarshalJSON() ([]byte, error)     { return marshalModel(a, a.Extras) }
+
+func (c *AssetCollection) UnmarshalJSON(data []byte) error { return unmarshalModel(data, c, &c.Extras) }
+func (c AssetCollection) MarshalJSON() ([]byte, error)     { return marshalModel(c, c.Extras) }
+
+func (e *Event) UnmarshalJSON(data []byte) error { return unmarshalModel(data, e, &e.Extras) }
+func (e Event) MarshalJSON() ([]byte, error)     { return marshalModel(e, e.Extras) }
+
+func (r *CastReaction) UnmarshalJSON(data []byte) error { return unmarshalModel(data, r, &r.Extras) }
+func (r CastReaction) MarshalJSON() ([]byte, error)     { return marshalModel(r, r.Extras) }
+
+func (r *AccessToken) UnmarshalJSON(data []byte) error { return unmarshalModel(data, r, &r.Extras) }
+func (r AccessToken) MarshalJSON() ([]byte, error)     { return marshalModel(r, r.Extras) }
+
+func (r *TokenResult) UnmarshalJSON(data []byte) error { return unmarshalModel(data, r, &r.Extras) }
+func (r TokenResult) MarshalJSON() ([]byte, error)     { return marshalModel(r, r.Extras) }
+
+func (c *StatusContent) UnmarshalJSON(data []byte) error { return unmarshalModel(data, c, &c.Extras) }
+func (c StatusContent) MarshalJSON() ([]byte, error)     { return marshalModel(c, c.Extras) }
+
+func (l *CastLike) UnmarshalJSON(data []byte) error { return unmarshalModel(data, l, &l.Extras) }
+func (l CastLike) MarshalJSON() ([]byte, error)     { return marshalModel(l, l.Extras) }
+
+func (r *ReactionsPutResult) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, r, &r.Extras)
+}
+func (r ReactionsPutResult) MarshalJSON() ([]byte, error) { return marshalModel(r, r.Extras) }
+
+func (r *RecastsPutResult) UnmarshalJSON(data []byte) error {
+	return unmarshalModel(data, r, &r.Extras)
+}
+func (r RecastsPutResult) MarshalJSON() ([]byte, error) { return marshalModel(r, r.Extras) }
+
+func (c *UnseenCounts) UnmarshalJSON(data []byte) error { return unmarshalModel(data, c, &c.Extras) }
+func (c UnseenCounts) MarshalJSON() ([]byte, error)     { return marshalModel(c, c.Extras) }
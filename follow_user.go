@@ -1,6 +1,7 @@
 package farcaster
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -14,11 +15,16 @@ import (
 //   - *StatusContent: Status of the follow operation
 //   - error: Any error that occurred
 func (w *Warpcast) FollowUser(fid int) (*StatusContent, error) {
+	return w.FollowUserContext(context.Background(), fid)
+}
+
+// FollowUserContext is like FollowUser but uses ctx for the request
+func (w *Warpcast) FollowUserContext(ctx context.Context, fid int) (*StatusContent, error) {
 	body := FollowsPutRequest{
 		TargetFid: fid,
 	}
 
-	resp, err := w.request("PUT", "follows", nil, body, nil)
+	resp, err := w.request(ctx, "PUT", "follows", nil, body, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to follow user: %w", err)
 	}
@@ -1,13 +1,14 @@
 package farcaster
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
 
 // CastsResult represents a collection of casts
 type CastsResult struct {
-	Casts []CastContent `json:"casts"`
+	Casts []ApiCast `json:"casts"`
 }
 
 // GetAllCastsInThread retrieves all casts in a thread
@@ -18,11 +19,16 @@ type CastsResult struct {
 //   - *CastsResult: A collection of casts
 //   - error: Any error that occurred
 func (w *Warpcast) GetAllCastsInThread(threadHash string) (*CastsResult, error) {
+	return w.GetAllCastsInThreadContext(context.Background(), threadHash)
+}
+
+// GetAllCastsInThreadContext is like GetAllCastsInThread but uses ctx for the request
+func (w *Warpcast) GetAllCastsInThreadContext(ctx context.Context, threadHash string) (*CastsResult, error) {
 	params := map[string]string{
 		"threadHash": threadHash,
 	}
 
-	resp, err := w.request("GET", "all-casts-in-thread", params, nil, nil)
+	resp, err := w.request(ctx, "GET", "all-casts-in-thread", params, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get thread casts: %w", err)
 	}
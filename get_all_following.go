@@ -1,7 +1,7 @@
 package farcaster
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 )
 
@@ -13,45 +13,25 @@ import (
 //   - *UsersResult: A collection of users
 //   - error: Any error that occurred
 func (w *Warpcast) GetAllFollowing(fid *int) (*UsersResult, error) {
+	return w.GetAllFollowingContext(context.Background(), fid)
+}
+
+// GetAllFollowingContext is like GetAllFollowing but uses ctx for every
+// request and stops paginating once ctx is done
+func (w *Warpcast) GetAllFollowingContext(ctx context.Context, fid *int) (*UsersResult, error) {
 	// If fid is nil, use authenticated user's fid
 	userFid := fid
 	if userFid == nil {
-		me, err := w.getMe()
+		me, err := w.getMe(ctx)
 		if err != nil {
 			return nil, err
 		}
 		userFid = &me.FID
 	}
 
-	var users []ApiUser
-	var cursor *string
-	limit := 100
-
-	for {
-		params := map[string]interface{}{
-			"fid":    *userFid,
-			"limit":  limit,
-			"cursor": cursor,
-		}
-
-		response, err := w.request("GET", "following", nil, params, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get following: %w", err)
-		}
-
-		var followingResponse FollowingGetResponse
-		if err := json.Unmarshal(response, &followingResponse); err != nil {
-			return nil, err
-		}
-
-		if len(followingResponse.Result.Users) > 0 {
-			users = append(users, followingResponse.Result.Users...)
-		}
-
-		if followingResponse.Next == nil {
-			break
-		}
-		cursor = &followingResponse.Next.Cursor
+	users, err := collect(w.FollowingIter(ctx, *userFid), 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get following: %w", err)
 	}
 
 	return &UsersResult{Users: users}, nil
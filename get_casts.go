@@ -1,9 +1,10 @@
 package farcaster
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
+	"reflect"
 )
 
 type IterableCastsResult struct {
@@ -11,14 +12,6 @@ type IterableCastsResult struct {
 	Cursor *string   `json:"cursor,omitempty"`
 }
 
-type ApiCast struct {
-	Hash       string   `json:"hash"`
-	ThreadHash string   `json:"threadHash"`
-	ParentHash *string  `json:"parentHash,omitempty"`
-	Author     ApiUser  `json:"author"`
-	Text       string   `json:"text"`
-}
-
 type CastsGetResponse struct {
 	Result struct {
 		Casts []ApiCast `json:"casts"`
@@ -37,6 +30,12 @@ type CastsGetResponse struct {
 //   - *IterableCastsResult: A collection of casts
 //   - error: Any error that occurred
 func (c *Warpcast) GetCasts(fid int, cursor *string, limit int) (*IterableCastsResult, error) {
+	return c.GetCastsContext(context.Background(), fid, cursor, limit)
+}
+
+// GetCastsContext is like GetCasts but uses ctx for every request and stops
+// paginating once ctx is done
+func (c *Warpcast) GetCastsContext(ctx context.Context, fid int, cursor *string, limit int) (*IterableCastsResult, error) {
 	if limit <= 0 {
 		limit = 25
 	}
@@ -44,69 +43,81 @@ func (c *Warpcast) GetCasts(fid int, cursor *string, limit int) (*IterableCastsR
 		limit = 100
 	}
 
-	var casts []ApiCast
-	currentCursor := cursor
+	pages := c.CastsIter(ctx, fid)
+	pages.SetCursor(cursor)
+
+	casts, err := collect(pages, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get casts: %w", err)
+	}
+
+	return &IterableCastsResult{
+		Casts:  casts,
+		Cursor: pages.Cursor(),
+	}, nil
+}
 
-	for {
+// CastsIter returns a paginator over every cast of the user with the given FID
+//
+// Parameters:
+//   - ctx: Context used for every page request
+//   - fid: Farcaster ID of the user
+//
+// Returns:
+//   - *Paginator[ApiCast]: A lazy paginator over the casts
+func (c *Warpcast) CastsIter(ctx context.Context, fid int) *Paginator[ApiCast] {
+	return newPaginator(ctx, func(ctx context.Context, cursor *string, limit int) ([]ApiCast, *string, error) {
 		params := map[string]interface{}{
-			"fid":   fid,
-			"limit": limit,
-		}
-		if currentCursor != nil {
-			params["cursor"] = *currentCursor
-		}
-		
-		response := &CastsGetResponse{}
-		err := c.get("casts", params, response)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get casts: %w", err)
+			"fid":    fid,
+			"limit":  limit,
+			"cursor": cursor,
 		}
 
-		if response.Result.Casts != nil {
-			casts = append(casts, response.Result.Casts...)
+		var response CastsGetResponse
+		if err := c.get(ctx, "casts", params, &response); err != nil {
+			return nil, nil, err
 		}
 
-		// Break if we've reached the limit
-		if response.Next.Cursor == nil || len(casts) >= limit {
-			break
+		return response.Result.Casts, response.Next.Cursor, nil
+	}, nil)
+}
+
+// get performs a GET request through request and decodes the response into
+// response. Nil pointer params are omitted and other pointers are dereferenced
+func (c *Warpcast) get(ctx context.Context, endpoint string, params map[string]interface{}, response interface{}) error {
+	query := make(map[string]string, len(params))
+	for k, v := range params {
+		if value, ok := queryValue(v); ok {
+			query[k] = value
 		}
-		currentCursor = response.Next.Cursor
 	}
 
-	// Trim casts to respect the limit
-	if len(casts) > limit {
-		casts = casts[:limit]
+	respBody, err := c.request(ctx, "GET", endpoint, query, nil, nil)
+	if err != nil {
+		return err
 	}
 
-	var nextCursor *string
-	if currentCursor != nil {
-		nextCursor = currentCursor
+	if err := json.Unmarshal(respBody, response); err != nil {
+		return fmt.Errorf("failed to unmarshal %s response: %w", endpoint, err)
 	}
 
-	return &IterableCastsResult{
-		Casts:  casts,
-		Cursor: nextCursor,
-	}, nil
+	return nil
 }
 
-func (c *Warpcast) get(endpoint string, params map[string]interface{}, response interface{}) error {
-	req, err := http.NewRequest("GET", c.BaseURL+"/"+endpoint, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	q := req.URL.Query()
-	for k, v := range params {
-		q.Add(k, fmt.Sprint(v))
+// queryValue formats v as a query parameter value, reporting false for nil
+// values that should be left out
+func queryValue(v interface{}) (string, bool) {
+	if v == nil {
+		return "", false
 	}
-	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return "", false
+		}
+		rv = rv.Elem()
 	}
-	defer resp.Body.Close()
 
-	return json.NewDecoder(resp.Body).Decode(response)
+	return fmt.Sprint(rv.Interface()), true
 }
-
@@ -1,6 +1,7 @@
 package farcaster
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -30,6 +31,12 @@ type FollowersResponse struct {
 //   - *IterableUsersResult: A collection of users
 //   - error: Any error that occurred
 func (w *Warpcast) GetFollowers(fid int, cursor *string, limit int) (*IterableUsersResult, error) {
+	return w.GetFollowersContext(context.Background(), fid, cursor, limit)
+}
+
+// GetFollowersContext is like GetFollowers but uses ctx for every request
+// and stops paginating once ctx is done
+func (w *Warpcast) GetFollowersContext(ctx context.Context, fid int, cursor *string, limit int) (*IterableUsersResult, error) {
 	if limit <= 0 {
 		limit = 25
 	}
@@ -37,43 +44,46 @@ func (w *Warpcast) GetFollowers(fid int, cursor *string, limit int) (*IterableUs
 		limit = 100
 	}
 
-	users := make([]ApiUser, 0)
-	currentCursor := cursor
-	var response FollowersResponse
-	
-	for {
+	pages := w.FollowersIter(ctx, fid)
+	pages.SetCursor(cursor)
+
+	users, err := collect(pages, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get followers: %w", err)
+	}
+
+	return &IterableUsersResult{
+		Users:  users,
+		Cursor: pages.Cursor(),
+	}, nil
+}
+
+// FollowersIter returns a paginator over every follower of the user with the
+// given FID
+//
+// Parameters:
+//   - ctx: Context used for every page request
+//   - fid: Farcaster ID of the user
+//
+// Returns:
+//   - *Paginator[ApiUser]: A lazy paginator over the followers
+func (w *Warpcast) FollowersIter(ctx context.Context, fid int) *Paginator[ApiUser] {
+	return newPaginator(ctx, func(ctx context.Context, cursor *string, limit int) ([]ApiUser, *string, error) {
 		params := map[string]interface{}{
 			"fid":    fid,
-			"cursor": currentCursor,
+			"cursor": cursor,
 			"limit":  limit,
 		}
-		
-		if err := w.get("followers", params, &response); err != nil {
-			return nil, fmt.Errorf("failed to get followers: %w", err)
-		}
 
-		if len(response.Result.Users) > 0 {
-			users = append(users, response.Result.Users...)
+		var response FollowersResponse
+		if err := w.get(ctx, "followers", params, &response); err != nil {
+			return nil, nil, err
 		}
 
-		if response.Next == nil || len(users) >= limit {
-			break
+		var next *string
+		if response.Next != nil {
+			next = &response.Next.Cursor
 		}
-		currentCursor = &response.Next.Cursor
-	}
-
-	// Trim users to respect limit
-	if len(users) > limit {
-		users = users[:limit]
-	}
-
-	var finalCursor *string
-	if response.Next != nil {
-		finalCursor = &response.Next.Cursor
-	}
-
-	return &IterableUsersResult{
-		Users:  users,
-		Cursor: finalCursor,
-	}, nil
+		return response.Result.Users, next, nil
+	}, nil)
 }
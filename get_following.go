@@ -1,7 +1,7 @@
 package farcaster
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 )
 
@@ -15,47 +15,55 @@ import (
 //   - *UsersResult: A collection of users
 //   - error: Any error that occurred
 func (w *Warpcast) GetFollowing(fid *int) (*UsersResult, error) {
-	var users []ApiUser
-	var cursor *string
-	limit := 100
+	return w.GetFollowingContext(context.Background(), fid)
+}
 
+// GetFollowingContext is like GetFollowing but uses ctx for every request
+// and stops paginating once ctx is done
+func (w *Warpcast) GetFollowingContext(ctx context.Context, fid *int) (*UsersResult, error) {
 	// If fid is nil, get the authenticated user's FID
 	if fid == nil {
-		me, err := w.getMe()
+		me, err := w.getMe(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get authenticated user: %w", err)
 		}
 		fid = &me.FID
 	}
 
-	for {
-		params := map[string]string{
-			"fid":   fmt.Sprintf("%d", *fid),
-			"limit": fmt.Sprintf("%d", limit),
-		}
-		if cursor != nil {
-			params["cursor"] = *cursor
-		}
+	users, err := collect(w.FollowingIter(ctx, *fid), 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get following: %w", err)
+	}
 
-		resp, err := w.request("GET", "following", params, nil, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get following: %w", err)
-		}
+	return &UsersResult{Users: users}, nil
+}
 
-		var response FollowingGetResponse
-		if err := json.Unmarshal(resp, &response); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal following response: %w", err)
+// FollowingIter returns a paginator over every user that the user with the
+// given FID is following
+//
+// Parameters:
+//   - ctx: Context used for every page request
+//   - fid: Farcaster ID of the user
+//
+// Returns:
+//   - *Paginator[ApiUser]: A lazy paginator over the followed users
+func (w *Warpcast) FollowingIter(ctx context.Context, fid int) *Paginator[ApiUser] {
+	return newPaginator(ctx, func(ctx context.Context, cursor *string, limit int) ([]ApiUser, *string, error) {
+		params := map[string]interface{}{
+			"fid":    fid,
+			"limit":  limit,
+			"cursor": cursor,
 		}
 
-		if len(response.Result.Users) > 0 {
-			users = append(users, response.Result.Users...)
+		var response FollowingGetResponse
+		if err := w.get(ctx, "following", params, &response); err != nil {
+			return nil, nil, err
 		}
 
-		if response.Next == nil {
-			break
+		var next *string
+		if response.Next != nil {
+			next = &response.Next.Cursor
 		}
-		cursor = &response.Next.Cursor
-	}
-
-	return &UsersResult{Users: users}, nil
-} 
\ No newline at end of file
+		return response.Result.Users, next, nil
+	}, nil)
+}
@@ -1,8 +1,9 @@
 package farcaster
 
 import (
+	"context"
 	"encoding/json"
-	"net/http"
+	"fmt"
 )
 
 type MeGetResponse struct {
@@ -11,23 +12,20 @@ type MeGetResponse struct {
 	} `json:"result"`
 }
 
-func (w *Warpcast) getMe() (*ApiUser, error) {
-	req, err := http.NewRequest("GET", w.config.BasePath+"me", nil)
+func (w *Warpcast) getMe(ctx context.Context) (*ApiUser, error) {
+	resp, err := w.request(ctx, "GET", "me", nil, nil, nil)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get me: %w", err)
 	}
 
-	resp, err := w.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
 	var response MeGetResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, err
+	if err := json.Unmarshal(resp, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal me response: %w", err)
 	}
 
+	w.mu.Lock()
 	w.config.Username = &response.Result.User.Username
+	w.mu.Unlock()
+
 	return &response.Result.User, nil
 }
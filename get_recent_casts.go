@@ -0,0 +1,61 @@
+package farcaster
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetRecentCasts retrieves the most recent casts across the network
+//
+// Parameters:
+//   - cursor: Cursor to start from (optional)
+//   - limit: Number of casts to retrieve (default 100, max 100)
+//
+// Returns:
+//   - *IterableCastsResult: A collection of casts
+//   - error: Any error that occurred
+func (w *Warpcast) GetRecentCasts(cursor *string, limit int) (*IterableCastsResult, error) {
+	return w.GetRecentCastsContext(context.Background(), cursor, limit)
+}
+
+// GetRecentCastsContext is like GetRecentCasts but uses ctx for the request
+func (w *Warpcast) GetRecentCastsContext(ctx context.Context, cursor *string, limit int) (*IterableCastsResult, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	params := map[string]interface{}{
+		"cursor": cursor,
+		"limit":  limit,
+	}
+
+	var response CastsGetResponse
+	if err := w.get(ctx, "recent-casts", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to get recent casts: %w", err)
+	}
+
+	return &IterableCastsResult{
+		Casts:  response.Result.Casts,
+		Cursor: response.Next.Cursor,
+	}, nil
+}
+
+// StreamCasts returns a stream of new casts across the network, deduplicated
+// by hash
+//
+// Parameters:
+//   - opts: Polling options of the stream
+//
+// Returns:
+//   - *Stream[ApiCast]: A stream to range over with All
+func (w *Warpcast) StreamCasts(opts StreamOptions) *Stream[ApiCast] {
+	return NewStream(func(ctx context.Context, limit int) ([]ApiCast, error) {
+		result, err := w.GetRecentCastsContext(ctx, nil, limit)
+		if err != nil {
+			return nil, err
+		}
+		return result.Casts, nil
+	}, func(cast ApiCast) string {
+		return cast.Hash
+	}, opts)
+}
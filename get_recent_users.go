@@ -0,0 +1,67 @@
+package farcaster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// GetRecentUsers retrieves the most recently registered users
+//
+// Parameters:
+//   - cursor: Cursor to start from (optional)
+//   - limit: Number of users to retrieve (default 100, max 100)
+//
+// Returns:
+//   - *IterableUsersResult: A collection of users
+//   - error: Any error that occurred
+func (w *Warpcast) GetRecentUsers(cursor *string, limit int) (*IterableUsersResult, error) {
+	return w.GetRecentUsersContext(context.Background(), cursor, limit)
+}
+
+// GetRecentUsersContext is like GetRecentUsers but uses ctx for the request
+func (w *Warpcast) GetRecentUsersContext(ctx context.Context, cursor *string, limit int) (*IterableUsersResult, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	params := map[string]interface{}{
+		"cursor": cursor,
+		"limit":  limit,
+	}
+
+	var response FollowersResponse
+	if err := w.get(ctx, "recent-users", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to get recent users: %w", err)
+	}
+
+	var nextCursor *string
+	if response.Next != nil {
+		nextCursor = &response.Next.Cursor
+	}
+
+	return &IterableUsersResult{
+		Users:  response.Result.Users,
+		Cursor: nextCursor,
+	}, nil
+}
+
+// StreamRecentUsers returns a stream of newly registered users, deduplicated
+// by FID
+//
+// Parameters:
+//   - opts: Polling options of the stream
+//
+// Returns:
+//   - *Stream[ApiUser]: A stream to range over with All
+func (w *Warpcast) StreamRecentUsers(opts StreamOptions) *Stream[ApiUser] {
+	return NewStream(func(ctx context.Context, limit int) ([]ApiUser, error) {
+		result, err := w.GetRecentUsersContext(ctx, nil, limit)
+		if err != nil {
+			return nil, err
+		}
+		return result.Users, nil
+	}, func(user ApiUser) string {
+		return strconv.Itoa(user.FID)
+	}, opts)
+}
@@ -0,0 +1,113 @@
+package farcaster
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+// LocalAccountFromKeystore decrypts an encrypted JSON (V3) keystore file, as
+// written by geth, clef or WriteKeystore
+//
+// Parameters:
+//   - path: Path of the keystore file
+//   - passphrase: Passphrase the key was encrypted with
+//
+// Returns:
+//   - *LocalAccount: The decrypted account with address and public key set
+//   - error: Any error that occurred
+func LocalAccountFromKeystore(path, passphrase string) (*LocalAccount, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore: %w", err)
+	}
+	return LocalAccountFromKeystoreJSON(keyJSON, passphrase)
+}
+
+// LocalAccountFromKeystoreJSON decrypts the contents of an encrypted JSON (V3)
+// keystore file
+//
+// Parameters:
+//   - keyJSON: Contents of the keystore file
+//   - passphrase: Passphrase the key was encrypted with
+//
+// Returns:
+//   - *LocalAccount: The decrypted account with address and public key set
+//   - error: Any error that occurred
+func LocalAccountFromKeystoreJSON(keyJSON []byte, passphrase string) (*LocalAccount, error) {
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+	return newLocalAccount(key.PrivateKey), nil
+}
+
+// NewWarpcastFromKeystore creates a client whose access tokens are minted
+// with the custody key of an encrypted JSON (V3) keystore file
+//
+// Parameters:
+//   - path: Path of the keystore file
+//   - passphrase: Passphrase the key was encrypted with
+//   - opts: Additional client options
+//
+// Returns:
+//   - *Warpcast: The authenticated client
+//   - error: Any error that occurred
+func NewWarpcastFromKeystore(path, passphrase string, opts ...WarpcastOption) (*Warpcast, error) {
+	account, err := LocalAccountFromKeystore(path, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWarpcast(append([]WarpcastOption{WithWallet(account)}, opts...)...)
+}
+
+// WriteKeystore encrypts the account's private key with passphrase and writes
+// it to path as a JSON (V3) keystore file readable only by the current user.
+// An existing file is never overwritten
+//
+// Parameters:
+//   - path: Path of the keystore file to create
+//   - passphrase: Passphrase to encrypt the key with
+//
+// Returns:
+//   - error: Any error that occurred
+func (a *LocalAccount) WriteKeystore(path, passphrase string) error {
+	privateKey, err := a.privateKey()
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("failed to generate keystore id: %w", err)
+	}
+
+	key := &keystore.Key{
+		Id:         id,
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+
+	keyJSON, err := keystore.EncryptKey(key, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt keystore: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create keystore: %w", err)
+	}
+	if _, err := file.Write(keyJSON); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write keystore: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write keystore: %w", err)
+	}
+
+	return nil
+}
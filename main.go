@@ -2,15 +2,14 @@ package farcaster
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"time"
-
-	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/crypto"
 )
 
 // NewWarpcast creates a new Warpcast client instance
@@ -19,8 +18,10 @@ func NewWarpcast(opts ...WarpcastOption) (*Warpcast, error) {
 		config: &ConfigurationParams{
 			BasePath: "https://api.warpcast.com/v2/",
 		},
-		rotationDuration: 10,
+		rotationDuration: 10 * time.Minute,
 		client:           &http.Client{},
+		retryPolicy:      DefaultRetryPolicy,
+		clock:            realClock{},
 		baseHeaders:      make(map[string]string),
 	}
 
@@ -29,6 +30,24 @@ func NewWarpcast(opts ...WarpcastOption) (*Warpcast, error) {
 		opt(w)
 	}
 
+	// A bare integer such as 200 is 200ns, not minutes, and would mint a
+	// token that expires at once on every request
+	if w.rotationDuration < MinRotationDuration {
+		return nil, fmt.Errorf("rotation duration %v is shorter than %v", w.rotationDuration, MinRotationDuration)
+	}
+
+	// Fail early on a wallet that cannot sign rather than at the first request
+	if w.wallet != nil {
+		wallet, err := validateLocalAccount(w.wallet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid wallet: %w", err)
+		}
+		w.wallet = wallet
+		if w.signer == nil {
+			w.signer = wallet
+		}
+	}
+
 	// Validate and setup authentication
 	if w.accessToken != nil {
 		w.baseHeaders["Authorization"] = fmt.Sprintf("Bearer %s", *w.accessToken)
@@ -37,17 +56,44 @@ func NewWarpcast(opts ...WarpcastOption) (*Warpcast, error) {
 			future := int64(33228645430000)
 			w.expiresAt = &future
 		}
-	} else if w.wallet == nil {
-		return nil, fmt.Errorf("no wallet or access token provided")
+	} else if w.signer == nil {
+		return nil, fmt.Errorf("no wallet, signer or access token provided")
 	} else {
-		if err := w.createNewAuthToken(w.rotationDuration); err != nil {
-			return nil, fmt.Errorf("failed to create auth token: %w", err)
+		reused, err := w.useStoredToken(context.Background(), time.Second)
+		if err != nil {
+			return nil, err
+		}
+		if !reused {
+			if err := w.createNewAuthToken(context.Background(), w.rotationDuration); err != nil {
+				return nil, fmt.Errorf("failed to create auth token: %w", err)
+			}
 		}
 	}
 
+	if w.refreshMargin > 0 && w.signer != nil {
+		w.startRefresher()
+	}
+
 	return w, nil
 }
 
+// NewWarpcastFromAccessToken creates a client that uses an existing access
+// token, e.g. one copied from the Warpcast app
+//
+// Parameters:
+//   - accessToken: The access token
+//   - opts: Additional client options
+//
+// Returns:
+//   - *Warpcast: The authenticated client
+//   - error: Any error that occurred
+func NewWarpcastFromAccessToken(accessToken string, opts ...WarpcastOption) (*Warpcast, error) {
+	if accessToken == "" {
+		return nil, fmt.Errorf("access token is empty")
+	}
+	return NewWarpcast(append([]WarpcastOption{WithAccessToken(accessToken, nil)}, opts...)...)
+}
+
 // WarpcastOption defines a function type for configuring the Warpcast client
 type WarpcastOption func(*Warpcast)
 
@@ -59,7 +105,39 @@ func WithAccessToken(token string, expiresAt *int64) WarpcastOption {
 	}
 }
 
+// WithBasePath sets the base path of the API, e.g. "https://api.warpcast.com/v2/"
+func WithBasePath(basePath string) WarpcastOption {
+	return func(w *Warpcast) {
+		if !strings.HasSuffix(basePath, "/") {
+			basePath += "/"
+		}
+		w.config.BasePath = basePath
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for every request
+func WithHTTPClient(client *http.Client) WarpcastOption {
+	return func(w *Warpcast) {
+		w.client = client
+	}
+}
+
+// MinRotationDuration is the shortest lifetime NewWarpcast accepts for minted
+// access tokens
+const MinRotationDuration = time.Minute
+
+// WithRotationDuration sets the lifetime of minted access tokens. It must be
+// at least MinRotationDuration
+func WithRotationDuration(duration time.Duration) WarpcastOption {
+	return func(w *Warpcast) {
+		w.rotationDuration = duration
+	}
+}
+
 // WithWallet sets the wallet for the client
+//
+// NewWarpcast validates the wallet and signs with the validated copy, unless
+// WithSigner sets another signer
 func WithWallet(wallet *LocalAccount) WarpcastOption {
 	return func(w *Warpcast) {
 		w.wallet = wallet
@@ -67,120 +145,258 @@ func WithWallet(wallet *LocalAccount) WarpcastOption {
 }
 
 // request performs an HTTP request and handles the response
-func (w *Warpcast) request(method, path string, params map[string]string, body interface{}, headers map[string]string) ([]byte, error) {
-	if err := w.checkAuthHeader(); err != nil {
-		return nil, fmt.Errorf("auth check failed: %w", err)
+//
+// Every endpoint goes through request so that authentication, headers, retries
+// and error handling behave the same way everywhere
+//
+// An Authorization entry in headers replaces the client's own token and skips
+// the token rotation check, which is how PutAuth sends the custody signature
+func (w *Warpcast) request(ctx context.Context, method, path string, params map[string]string, body interface{}, headers map[string]string) ([]byte, error) {
+	if _, ok := headers["Authorization"]; !ok {
+		if err := w.checkAuthHeader(ctx); err != nil {
+			return nil, fmt.Errorf("auth check failed: %w", err)
+		}
 	}
 
-	url := w.config.BasePath + path
+	url := w.basePath() + path
 
-	// Create request
-	var reqBody io.Reader
+	// Marshal the body once so every attempt can re-read it
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
+	baseHeaders := w.headers()
 
-	// Add query parameters
-	if params != nil {
-		q := req.URL.Query()
-		for k, v := range params {
-			q.Add(k, v)
+	return w.doWithRetry(ctx, method, path, func() (*http.Request, error) {
+		var reqBody io.Reader
+		if jsonBody != nil {
+			reqBody = bytes.NewReader(jsonBody)
 		}
-		req.URL.RawQuery = q.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		// Add query parameters
+		if params != nil {
+			q := req.URL.Query()
+			for k, v := range params {
+				q.Add(k, v)
+			}
+			req.URL.RawQuery = q.Encode()
+		}
+
+		// Add headers
+		for k, v := range baseHeaders {
+			req.Header.Set(k, v)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		return req, nil
+	})
+}
+
+// GetHealthcheck checks if the API is up and running
+func (w *Warpcast) GetHealthcheck() (bool, error) {
+	return w.GetHealthcheckContext(context.Background())
+}
+
+// GetHealthcheckContext is like GetHealthcheck but uses ctx for the request
+func (w *Warpcast) GetHealthcheckContext(ctx context.Context) (bool, error) {
+	// The healthcheck lives next to the versioned API rather than under it and
+	// needs no token, so it uses the retry core without request
+	url := strings.TrimSuffix(strings.TrimSuffix(w.basePath(), "/"), "/v2") + "/healthcheck"
+
+	_, err := w.doWithRetry(ctx, "GET", "healthcheck", func() (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, "GET", url, nil)
+	})
+
+	// An API error means the API answered but is not healthy
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get healthcheck: %w", err)
 	}
+	return true, nil
+}
 
-	// Add headers
-	for k, v := range w.baseHeaders {
-		req.Header.Set(k, v)
+// basePath returns the base path of the API, honoring the deprecated BaseURL
+// field
+func (w *Warpcast) basePath() string {
+	if w.BaseURL != "" {
+		return strings.TrimSuffix(w.BaseURL, "/") + "/"
 	}
-	for k, v := range headers {
-		req.Header.Set(k, v)
+	return w.config.BasePath
+}
+
+// httpClient returns the HTTP client for requests, honoring the deprecated
+// HTTPClient field
+func (w *Warpcast) httpClient() *http.Client {
+	if w.HTTPClient != nil {
+		return w.HTTPClient
 	}
-	req.Header.Set("Content-Type", "application/json")
+	return w.client
+}
 
-	// Make request
-	resp, err := w.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+// checkAuthHeader verifies and refreshes the authentication token if needed
+func (w *Warpcast) checkAuthHeader(ctx context.Context) error {
+	return w.refreshToken(ctx, time.Second)
+}
+
+// refreshToken rotates the access token if it expires within margin
+//
+// Concurrent callers that find the token expiring wait for a single
+// rotation instead of each minting their own token
+func (w *Warpcast) refreshToken(ctx context.Context, margin time.Duration) error {
+	expiring, err := w.tokenExpiring(margin)
+	if err != nil || !expiring {
+		return err
 	}
-	defer resp.Body.Close()
 
-	// Read response
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+	w.rotateMu.Lock()
+	defer w.rotateMu.Unlock()
+
+	// Another goroutine may have rotated while we were waiting
+	if expiring, err := w.tokenExpiring(margin); err != nil || !expiring {
+		return err
 	}
 
-	// Check for API errors
-	var result map[string]interface{}
-	if err := json.Unmarshal(respBody, &result); err == nil {
-		if errors, ok := result["errors"]; ok {
-			return nil, fmt.Errorf("API error: %v", errors)
-		}
+	// Another process sharing the token store may already have rotated
+	if reused, err := w.useStoredToken(ctx, margin); err != nil || reused {
+		return err
 	}
 
-	return respBody, nil
-}
+	w.mu.RLock()
+	duration := w.rotationDuration
+	w.mu.RUnlock()
 
-// GetHealthcheck checks if the API is up and running
-func (w *Warpcast) GetHealthcheck() (bool, error) {
-	resp, err := w.client.Get("https://api.warpcast.com/healthcheck")
-	if err != nil {
-		return false, err
+	if err := w.createNewAuthToken(ctx, duration); err != nil {
+		return fmt.Errorf("failed to refresh auth token: %w", err)
 	}
-	defer resp.Body.Close()
-	return resp.StatusCode == http.StatusOK, nil
+	return nil
 }
 
-// checkAuthHeader verifies and refreshes the authentication token if needed
-func (w *Warpcast) checkAuthHeader() error {
+// tokenExpiring reports whether the access token expires within margin
+func (w *Warpcast) tokenExpiring(margin time.Duration) (bool, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
 	if w.expiresAt == nil {
-		return fmt.Errorf("expires_at is not set")
+		return false, fmt.Errorf("expires_at is not set")
 	}
+	return *w.expiresAt < w.clock.Now().UnixMilli()+margin.Milliseconds(), nil
+}
 
-	if *w.expiresAt < nowMs()+1000 {
-		if err := w.createNewAuthToken(w.rotationDuration); err != nil {
-			return fmt.Errorf("failed to refresh auth token: %w", err)
-		}
+// headers returns a copy of the headers sent with every request
+func (w *Warpcast) headers() map[string]string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	headers := make(map[string]string, len(w.baseHeaders))
+	for k, v := range w.baseHeaders {
+		headers[k] = v
 	}
-	return nil
+	return headers
 }
 
-// nowMs returns the current time in milliseconds
-func nowMs() int64 {
-	return time.Now().UnixNano() / int64(time.Millisecond)
+// useStoredToken adopts the token from the token store if it is valid for at
+// least margin, reporting whether it did
+func (w *Warpcast) useStoredToken(ctx context.Context, margin time.Duration) (bool, error) {
+	if w.tokenStore == nil {
+		return false, nil
+	}
+
+	token, err := w.tokenStore.Load(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to load stored token: %w", err)
+	}
+	if !token.valid(w.clock.Now().UnixMilli(), margin.Milliseconds()) {
+		return false, nil
+	}
+
+	w.setToken(token.Secret, token.ExpiresAt)
+	return true, nil
 }
 
-// createNewAuthToken generates a new authentication token
-func (w *Warpcast) createNewAuthToken(duration int64) error {
-	// TODO: Implement actual token creation logic
-	// This is a placeholder implementation
-	token := "temporary_token"
-	expiresAt := nowMs() + (duration * 60 * 1000) // Convert minutes to milliseconds
+// setToken makes token the client's access token
+func (w *Warpcast) setToken(token string, expiresAt int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
 
 	w.accessToken = &token
 	w.expiresAt = &expiresAt
+	w.tokenSetAt = w.clock.Now().UnixMilli()
 	w.baseHeaders["Authorization"] = fmt.Sprintf("Bearer %s", token)
+}
+
+// createNewAuthToken mints a new access token signed by the custody wallet
+// that stays valid for duration
+func (w *Warpcast) createNewAuthToken(ctx context.Context, duration time.Duration) error {
+	now := w.clock.Now().UnixMilli()
+	authParams := &AuthParams{
+		Timestamp: now,
+		ExpiresAt: now + duration.Milliseconds(),
+	}
+
+	result, err := w.PutAuthContext(ctx, authParams)
+	if err != nil {
+		return err
+	}
+	if result.Token.Secret == "" {
+		return fmt.Errorf("auth response did not contain a token")
+	}
+
+	token := result.Token.Secret
+	expiresAt := authParams.ExpiresAt
+	if result.Token.ExpiresAt != 0 {
+		expiresAt = result.Token.ExpiresAt
+	}
+
+	w.setToken(token, expiresAt)
+
+	w.mu.Lock()
+	w.rotationDuration = duration
+	w.mu.Unlock()
+
+	if w.tokenStore != nil {
+		if err := w.tokenStore.Save(ctx, &StoredToken{Secret: token, ExpiresAt: expiresAt}); err != nil {
+			return fmt.Errorf("failed to store auth token: %w", err)
+		}
+	}
 
 	return nil
 }
 
-// GetAsset retrieves asset information
+// GetAsset retrieves an NFT with its owner and collection
+//
+// Parameters:
+//   - tokenID: Token ID of the asset
+//
+// Returns:
+//   - *AssetResult: The asset
+//   - error: Any error that occurred
 func (w *Warpcast) GetAsset(tokenID int) (*AssetResult, error) {
+	return w.GetAssetContext(context.Background(), tokenID)
+}
+
+// GetAssetContext is like GetAsset but uses ctx for the request
+func (w *Warpcast) GetAssetContext(ctx context.Context, tokenID int) (*AssetResult, error) {
 	params := map[string]string{
 		"token_id": fmt.Sprintf("%d", tokenID),
 	}
 
-	resp, err := w.request("GET", "asset", params, nil, nil)
+	resp, err := w.request(ctx, "GET", "asset", params, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get asset: %w", err)
 	}
@@ -195,8 +411,28 @@ func (w *Warpcast) GetAsset(tokenID int) (*AssetResult, error) {
 	return &result.Result, nil
 }
 
-// GetAssetEvents retrieves events for a given asset
+// GetAssetEvents retrieves the most recent NFT events, such as transfers
+//
+// Parameters:
+//   - cursor: Cursor to start from (optional)
+//   - limit: Number of events to retrieve (default 25, max 100)
+//
+// Returns:
+//   - *IterableEventsResult: A collection of events
+//   - error: Any error that occurred
 func (w *Warpcast) GetAssetEvents(cursor *string, limit int) (*IterableEventsResult, error) {
+	return w.GetAssetEventsContext(context.Background(), cursor, limit)
+}
+
+// GetAssetEventsContext is like GetAssetEvents but uses ctx for the request
+func (w *Warpcast) GetAssetEventsContext(ctx context.Context, cursor *string, limit int) (*IterableEventsResult, error) {
+	if limit <= 0 {
+		limit = 25
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
 	params := map[string]string{
 		"limit": fmt.Sprintf("%d", limit),
 	}
@@ -204,7 +440,7 @@ func (w *Warpcast) GetAssetEvents(cursor *string, limit int) (*IterableEventsRes
 		params["cursor"] = *cursor
 	}
 
-	resp, err := w.request("GET", "asset-events", params, nil, nil)
+	resp, err := w.request(ctx, "GET", "asset-events", params, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get asset events: %w", err)
 	}
@@ -232,16 +468,37 @@ func (w *Warpcast) GetAssetEvents(cursor *string, limit int) (*IterableEventsRes
 	}, nil
 }
 
+// AssetEventsIter returns a paginator over every asset event
+//
+// Parameters:
+//   - ctx: Context used for every page request
+//
+// Returns:
+//   - *Paginator[Event]: A lazy paginator over the asset events
+func (w *Warpcast) AssetEventsIter(ctx context.Context) *Paginator[Event] {
+	return newPaginator(ctx, func(ctx context.Context, cursor *string, limit int) ([]Event, *string, error) {
+		result, err := w.GetAssetEventsContext(ctx, cursor, limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Events, result.Cursor, nil
+	}, nil)
+}
+
 // PutAuth generates a custody bearer token and uses it to generate an access token
 func (w *Warpcast) PutAuth(authParams *AuthParams) (*TokenResult, error) {
-	header, err := w.generateCustodyAuthHeader(authParams)
+	return w.PutAuthContext(context.Background(), authParams)
+}
+
+// PutAuthContext is like PutAuth but uses ctx for the request
+func (w *Warpcast) PutAuthContext(ctx context.Context, authParams *AuthParams) (*TokenResult, error) {
+	header, err := w.generateCustodyAuthHeader(ctx, authParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate auth header: %w", err)
 	}
 
-	body := struct {
-		Params *AuthParams `json:"params"`
-	}{
+	body := AuthPutRequest{
+		Method: "generateToken",
 		Params: authParams,
 	}
 
@@ -249,7 +506,7 @@ func (w *Warpcast) PutAuth(authParams *AuthParams) (*TokenResult, error) {
 		"Authorization": header,
 	}
 
-	resp, err := w.request("PUT", "auth", nil, body, headers)
+	resp, err := w.request(ctx, "PUT", "auth", nil, body, headers)
 	if err != nil {
 		return nil, fmt.Errorf("failed to put auth: %w", err)
 	}
@@ -266,7 +523,12 @@ func (w *Warpcast) PutAuth(authParams *AuthParams) (*TokenResult, error) {
 
 // DeleteAuth deletes an access token
 func (w *Warpcast) DeleteAuth() (*StatusContent, error) {
-	timestamp := nowMs()
+	return w.DeleteAuthContext(context.Background())
+}
+
+// DeleteAuthContext is like DeleteAuth but uses ctx for the request
+func (w *Warpcast) DeleteAuthContext(ctx context.Context) (*StatusContent, error) {
+	timestamp := w.clock.Now().UnixMilli()
 	body := struct {
 		Params struct {
 			Timestamp int64 `json:"timestamp"`
@@ -279,7 +541,7 @@ func (w *Warpcast) DeleteAuth() (*StatusContent, error) {
 		},
 	}
 
-	resp, err := w.request("DELETE", "auth", nil, body, nil)
+	resp, err := w.request(ctx, "DELETE", "auth", nil, body, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete auth: %w", err)
 	}
@@ -291,52 +553,30 @@ func (w *Warpcast) DeleteAuth() (*StatusContent, error) {
 		return nil, fmt.Errorf("failed to unmarshal delete auth response: %w", err)
 	}
 
-	return &result.Result, nil
-}
-
-// generateCustodyAuthHeader generates the custody authorization header
-func (w *Warpcast) generateCustodyAuthHeader(authParams *AuthParams) (string, error) {
-	if w.wallet == nil {
-		return "", fmt.Errorf("wallet is required for custody auth")
-	}
-
-	// Convert auth params to JSON
-	message, err := json.Marshal(authParams)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal auth params: %w", err)
-	}
-
-	// Create the message hash (Keccak256)
-	messageHash := crypto.Keccak256Hash(message)
-
-	// Convert private key string to ECDSA private key
-	privateKeyECDSA, err := crypto.HexToECDSA(strings.TrimPrefix(w.wallet.PrivateKey, "0x"))
-	if err != nil {
-		return "", fmt.Errorf("invalid private key: %w", err)
-	}
-
-	// Sign the message hash
-	signature, err := crypto.Sign(messageHash.Bytes(), privateKeyECDSA)
-	if err != nil {
-		return "", fmt.Errorf("failed to sign message: %w", err)
+	// The revoked token must not be picked up again on the next start
+	if w.tokenStore != nil {
+		if err := w.tokenStore.Clear(ctx); err != nil {
+			return nil, fmt.Errorf("failed to clear stored token: %w", err)
+		}
 	}
 
-	// Format the signature as hex string
-	signatureHex := hexutil.Encode(signature)
-
-	// Create the custody authorization header
-	return fmt.Sprintf("Bearer %s", signatureHex), nil
+	return &result.Result, nil
 }
 
 // LikeCast likes a given cast
 func (w *Warpcast) LikeCast(castHash string) (*ReactionsPutResult, error) {
+	return w.LikeCastContext(context.Background(), castHash)
+}
+
+// LikeCastContext is like LikeCast but uses ctx for the request
+func (w *Warpcast) LikeCastContext(ctx context.Context, castHash string) (*ReactionsPutResult, error) {
 	body := struct {
 		CastHash string `json:"castHash"`
 	}{
 		CastHash: castHash,
 	}
 
-	resp, err := w.request("PUT", "cast-likes", nil, body, nil)
+	resp, err := w.request(ctx, "PUT", "cast-likes", nil, body, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to like cast: %w", err)
 	}
@@ -352,12 +592,17 @@ func (w *Warpcast) LikeCast(castHash string) (*ReactionsPutResult, error) {
 }
 
 // GetCast retrieves a specific cast by its hash
-func (w *Warpcast) GetCast(hash string) (*CastContent, error) {
+func (w *Warpcast) GetCast(hash string) (*ApiCast, error) {
+	return w.GetCastContext(context.Background(), hash)
+}
+
+// GetCastContext is like GetCast but uses ctx for the request
+func (w *Warpcast) GetCastContext(ctx context.Context, hash string) (*ApiCast, error) {
 	params := map[string]string{
 		"hash": hash,
 	}
 
-	resp, err := w.request("GET", "cast", params, nil, nil)
+	resp, err := w.request(ctx, "GET", "cast", params, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cast: %w", err)
 	}
@@ -369,5 +614,5 @@ func (w *Warpcast) GetCast(hash string) (*CastContent, error) {
 		return nil, fmt.Errorf("failed to unmarshal cast response: %w", err)
 	}
 
-	return &result.Result, nil
+	return &result.Result.Cast, nil
 }
\ No newline at end of file
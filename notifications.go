@@ -0,0 +1,261 @@
+package farcaster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Notification types returned by the mention and reply notifications endpoint
+const (
+	MentionNotificationType = "cast-mention"
+	ReplyNotificationType   = "cast-reply"
+)
+
+// Notification is a mention or reply notification. Use a type switch on
+// *MentionNotification, *ReplyNotification and *UnknownNotification to handle
+// each kind
+type Notification interface {
+	// NotificationID returns the unique ID of the notification
+	NotificationID() string
+	// NotificationType returns the type of the notification, such as
+	// MentionNotificationType
+	NotificationType() string
+
+	isNotification()
+}
+
+// NotificationContent holds the cast a notification is about
+type NotificationContent struct {
+	Cast ApiCast `json:"cast"`
+
+	Extras Extras `json:"-"`
+}
+
+// MentionNotification is sent when the authenticated user is mentioned in a
+// cast
+type MentionNotification struct {
+	Type      string              `json:"type"`
+	ID        string              `json:"id"`
+	Timestamp int64               `json:"timestamp"`
+	Actor     ApiUser             `json:"actor"`
+	Content   NotificationContent `json:"content"`
+
+	Extras Extras `json:"-"`
+}
+
+// ReplyNotification is sent when a cast replies to one of the authenticated
+// user's casts
+type ReplyNotification struct {
+	Type      string              `json:"type"`
+	ID        string              `json:"id"`
+	Timestamp int64               `json:"timestamp"`
+	Actor     ApiUser             `json:"actor"`
+	Content   NotificationContent `json:"content"`
+
+	Extras Extras `json:"-"`
+}
+
+// UnknownNotification is a notification of a type this package does not
+// model yet. Raw holds the notification as returned by the API
+type UnknownNotification struct {
+	Type string
+	ID   string
+	Raw  json.RawMessage
+}
+
+func (n *MentionNotification) NotificationID() string   { return n.ID }
+func (n *MentionNotification) NotificationType() string { return MentionNotificationType }
+func (n *MentionNotification) isNotification()          {}
+
+func (n *ReplyNotification) NotificationID() string   { return n.ID }
+func (n *ReplyNotification) NotificationType() string { return ReplyNotificationType }
+func (n *ReplyNotification) isNotification()          {}
+
+func (n *UnknownNotification) NotificationID() string   { return n.ID }
+func (n *UnknownNotification) NotificationType() string { return n.Type }
+func (n *UnknownNotification) isNotification()          {}
+
+// MarshalJSON returns the notification as returned by the API, or null if
+// Raw is empty
+func (n *UnknownNotification) MarshalJSON() ([]byte, error) {
+	if len(n.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return n.Raw, nil
+}
+
+// decodeNotification decodes a single notification into its concrete type
+func decodeNotification(data json.RawMessage) (Notification, error) {
+	var header struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	switch header.Type {
+	case MentionNotificationType:
+		var n MentionNotification
+		if err := json.Unmarshal(data, &n); err != nil {
+			return nil, err
+		}
+		return &n, nil
+	case ReplyNotificationType:
+		var n ReplyNotification
+		if err := json.Unmarshal(data, &n); err != nil {
+			return nil, err
+		}
+		return &n, nil
+	default:
+		return &UnknownNotification{Type: header.Type, ID: header.ID, Raw: data}, nil
+	}
+}
+
+// IterableNotificationsResult represents a paginated list of notifications
+type IterableNotificationsResult struct {
+	Notifications []Notification `json:"notifications"`
+	Cursor        *string        `json:"cursor,omitempty"`
+}
+
+// NotificationsGetResponse represents the API response for notifications
+type NotificationsGetResponse struct {
+	Result struct {
+		Notifications []json.RawMessage `json:"notifications"`
+	} `json:"result"`
+	Next *struct {
+		Cursor string `json:"cursor"`
+	} `json:"next,omitempty"`
+}
+
+// UnseenGetResponse represents the API response for unseen counts
+type UnseenGetResponse struct {
+	Result UnseenCounts `json:"result"`
+}
+
+// UnseenCounts holds the number of unseen notifications and inbox messages
+type UnseenCounts struct {
+	NotificationsCount int `json:"notificationsCount"`
+	InboxCount         int `json:"inboxCount"`
+
+	Extras Extras `json:"-"`
+}
+
+// GetMentionAndReplyNotifications retrieves mention and reply notifications
+// for the authenticated user, newest first
+//
+// Parameters:
+//   - cursor: Cursor to start from (optional)
+//   - limit: Number of notifications to retrieve (default 25, max 100)
+//
+// Returns:
+//   - *IterableNotificationsResult: A collection of notifications
+//   - error: Any error that occurred
+func (w *Warpcast) GetMentionAndReplyNotifications(cursor *string, limit int) (*IterableNotificationsResult, error) {
+	return w.GetMentionAndReplyNotificationsContext(context.Background(), cursor, limit)
+}
+
+// GetMentionAndReplyNotificationsContext is like
+// GetMentionAndReplyNotifications but uses ctx for every request and stops
+// paginating once ctx is done
+func (w *Warpcast) GetMentionAndReplyNotificationsContext(ctx context.Context, cursor *string, limit int) (*IterableNotificationsResult, error) {
+	if limit <= 0 {
+		limit = 25
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	pages := w.NotificationsIter(ctx)
+	pages.SetCursor(cursor)
+
+	notifications, err := collect(pages, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	return &IterableNotificationsResult{
+		Notifications: notifications,
+		Cursor:        pages.Cursor(),
+	}, nil
+}
+
+// NotificationsIter returns a paginator over every mention and reply
+// notification of the authenticated user, newest first
+//
+// Parameters:
+//   - ctx: Context used for every page request
+//
+// Returns:
+//   - *Paginator[Notification]: A lazy paginator over the notifications
+func (w *Warpcast) NotificationsIter(ctx context.Context) *Paginator[Notification] {
+	return newPaginator(ctx, w.getMentionAndReplyNotifications, nil)
+}
+
+// getMentionAndReplyNotifications retrieves a page of mention and reply
+// notifications for the authenticated user
+func (w *Warpcast) getMentionAndReplyNotifications(ctx context.Context, cursor *string, limit int) ([]Notification, *string, error) {
+	params := map[string]interface{}{
+		"cursor": cursor,
+		"limit":  limit,
+	}
+
+	var response NotificationsGetResponse
+	if err := w.get(ctx, "mention-and-reply-notifications", params, &response); err != nil {
+		return nil, nil, err
+	}
+
+	notifications := make([]Notification, 0, len(response.Result.Notifications))
+	for _, raw := range response.Result.Notifications {
+		notification, err := decodeNotification(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal notification: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	var nextCursor *string
+	if response.Next != nil {
+		nextCursor = &response.Next.Cursor
+	}
+	return notifications, nextCursor, nil
+}
+
+// GetUnseenNotificationsCount retrieves the number of notifications the
+// authenticated user has not seen yet
+//
+// Returns:
+//   - int: The number of unseen notifications
+//   - error: Any error that occurred
+func (w *Warpcast) GetUnseenNotificationsCount() (int, error) {
+	return w.GetUnseenNotificationsCountContext(context.Background())
+}
+
+// GetUnseenNotificationsCountContext is like GetUnseenNotificationsCount but
+// uses ctx for the request
+func (w *Warpcast) GetUnseenNotificationsCountContext(ctx context.Context) (int, error) {
+	var response UnseenGetResponse
+	if err := w.get(ctx, "unseen", nil, &response); err != nil {
+		return 0, fmt.Errorf("failed to get unseen notifications count: %w", err)
+	}
+	return response.Result.NotificationsCount, nil
+}
+
+// StreamNotifications returns a stream of new mention and reply notifications
+// for the authenticated user, deduplicated by notification ID
+//
+// Parameters:
+//   - opts: Polling options of the stream
+//
+// Returns:
+//   - *Stream[Notification]: A stream to range over with All
+func (w *Warpcast) StreamNotifications(opts StreamOptions) *Stream[Notification] {
+	return NewStream(func(ctx context.Context, limit int) ([]Notification, error) {
+		notifications, _, err := w.getMentionAndReplyNotifications(ctx, nil, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notifications: %w", err)
+		}
+		return notifications, nil
+	}, Notification.NotificationID, opts)
+}
@@ -0,0 +1,185 @@
+package farcaster
+
+import (
+	"context"
+	"iter"
+)
+
+// maxPageSize is the largest page size accepted by the paginated endpoints
+const maxPageSize = 100
+
+// pageFetcher fetches the page at cursor, returning its items and the cursor
+// of the next page, or nil when there are no more pages
+type pageFetcher[T any] func(ctx context.Context, cursor *string, limit int) ([]T, *string, error)
+
+// Paginator lazily walks a cursor-paginated endpoint
+//
+// Pages are only requested while the consumer keeps ranging over All, so
+// arbitrarily large lists can be processed without holding them in memory
+//
+// The *Iter methods of Warpcast, such as CastsIter, return a Paginator rather
+// than an iter.Seq2 so that the resume cursor can be read and set. Range over
+// All for the iter.Seq2:
+//
+//	for cast, err := range client.CastsIter(ctx, fid).All() {
+//		...
+//	}
+type Paginator[T any] struct {
+	ctx      context.Context
+	fetch    pageFetcher[T]
+	pageSize int
+	// cursor is the cursor of the current page
+	cursor *string
+	// offset is the number of items of the current page already yielded
+	offset int
+	// page holds the current page once fetched, and next the cursor after it
+	page []T
+	next *string
+	done bool
+}
+
+// newPaginator returns a paginator that starts at cursor, or at the first
+// page when cursor is nil
+func newPaginator[T any](ctx context.Context, fetch pageFetcher[T], cursor *string) *Paginator[T] {
+	return &Paginator[T]{
+		ctx:      ctx,
+		fetch:    fetch,
+		pageSize: maxPageSize,
+		cursor:   cursor,
+	}
+}
+
+// Cursor returns the cursor of the page iteration stopped in, or nil once
+// every page has been consumed
+//
+// If iteration stopped part-way through a page, Offset items of that page
+// have already been yielded. Pass both to SetCursor and SetOffset to resume
+// exactly where iteration stopped
+func (p *Paginator[T]) Cursor() *string {
+	return p.cursor
+}
+
+// Offset returns the number of items of the page at Cursor that have already
+// been yielded
+func (p *Paginator[T]) Offset() int {
+	return p.offset
+}
+
+// SetCursor sets the cursor to continue from, e.g. one saved from Cursor in
+// an earlier run
+func (p *Paginator[T]) SetCursor(cursor *string) {
+	p.cursor = cursor
+	p.offset = 0
+	p.page, p.next = nil, nil
+	p.done = false
+}
+
+// SetOffset skips the first offset items of the page at Cursor, e.g. an
+// Offset saved in an earlier run. The page size must match the earlier run
+// for the same items to be skipped
+func (p *Paginator[T]) SetOffset(offset int) {
+	p.offset = max(offset, 0)
+	p.page, p.next = nil, nil
+}
+
+// Done reports whether every page has been consumed
+func (p *Paginator[T]) Done() bool {
+	return p.done
+}
+
+// SetPageSize sets the number of items requested per page (default and max 100)
+func (p *Paginator[T]) SetPageSize(size int) {
+	if size <= 0 || size > maxPageSize {
+		size = maxPageSize
+	}
+	p.pageSize = size
+}
+
+// All returns an iterator over the remaining items
+//
+// Iteration stops at the first error, which is yielded with the zero value,
+// and when the paginator's context is done. Ranging over All again continues
+// after the last yielded item
+func (p *Paginator[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for {
+			if p.page == nil {
+				if p.done {
+					return
+				}
+				if err := p.ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+
+				items, next, err := p.fetch(p.ctx, p.cursor, p.pageSize)
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+				if items == nil {
+					items = []T{}
+				}
+				p.page, p.next = items, next
+			}
+
+			for p.offset < len(p.page) {
+				item := p.page[p.offset]
+				p.offset++
+				if !yield(item, nil) {
+					if p.offset == len(p.page) {
+						p.nextPage()
+					}
+					return
+				}
+			}
+			p.nextPage()
+		}
+	}
+}
+
+// pageConsumed reports whether every item of the current page has been yielded
+func (p *Paginator[T]) pageConsumed() bool {
+	return p.offset >= len(p.page)
+}
+
+// nextPage moves past the current page
+func (p *Paginator[T]) nextPage() {
+	p.cursor = p.next
+	p.done = p.next == nil
+	p.offset = 0
+	p.page, p.next = nil, nil
+}
+
+// collect gathers up to limit items from p, or every item when limit is 0
+//
+// If the API returns a larger page than requested, collect finishes that page
+// and may return more than limit items. The paginator's cursor then points at
+// an unread page, so resuming from it never repeats items
+func collect[T any](p *Paginator[T], limit int) ([]T, error) {
+	items := make([]T, 0)
+	if limit > 0 {
+		p.SetPageSize(limit)
+	}
+
+	for item, err := range p.All() {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+
+		if limit > 0 {
+			if len(items) >= limit {
+				if p.pageConsumed() {
+					break
+				}
+				continue
+			}
+			// Only ask for what is still missing
+			p.SetPageSize(limit - len(items))
+		}
+	}
+
+	return items, nil
+}
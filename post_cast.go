@@ -1,6 +1,7 @@
 package farcaster
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -14,9 +15,14 @@ import (
 //   - channelKey: optional channel of the cast
 //
 // Returns:
-//   - *CastContent: The result of posting the cast
+//   - *ApiCast: The posted cast
 //   - error: Any error that occurred
-func (w *Warpcast) PostCast(text string, embeds []string, parent *Parent, channelKey *string) (*CastContent, error) {
+func (w *Warpcast) PostCast(text string, embeds []string, parent *Parent, channelKey *string) (*ApiCast, error) {
+	return w.PostCastContext(context.Background(), text, embeds, parent, channelKey)
+}
+
+// PostCastContext is like PostCast but uses ctx for the request
+func (w *Warpcast) PostCastContext(ctx context.Context, text string, embeds []string, parent *Parent, channelKey *string) (*ApiCast, error) {
 	// Create request body
 	body := CastsPostRequest{
 		Text:       text,
@@ -26,7 +32,7 @@ func (w *Warpcast) PostCast(text string, embeds []string, parent *Parent, channe
 	}
 
 	// Make the request
-	resp, err := w.request("POST", "casts", nil, body, nil)
+	resp, err := w.request(ctx, "POST", "casts", nil, body, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to post cast: %w", err)
 	}
@@ -37,5 +43,5 @@ func (w *Warpcast) PostCast(text string, embeds []string, parent *Parent, channe
 		return nil, fmt.Errorf("failed to unmarshal cast response: %w", err)
 	}
 
-	return &result.Result, nil
+	return &result.Result.Cast, nil
 } 
\ No newline at end of file
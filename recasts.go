@@ -0,0 +1,148 @@
+package farcaster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Recast recasts a given cast
+//
+// Parameters:
+//   - castHash: Hash of the cast to recast
+//
+// Returns:
+//   - *RecastsPutResult: The hash of the recast cast
+//   - error: Any error that occurred
+func (w *Warpcast) Recast(castHash string) (*RecastsPutResult, error) {
+	return w.RecastContext(context.Background(), castHash)
+}
+
+// RecastContext is like Recast but uses ctx for the request
+func (w *Warpcast) RecastContext(ctx context.Context, castHash string) (*RecastsPutResult, error) {
+	body := RecastsPutRequest{
+		CastHash: castHash,
+	}
+
+	resp, err := w.request(ctx, "PUT", "recasts", nil, body, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recast: %w", err)
+	}
+
+	var result struct {
+		Result RecastsPutResult `json:"result"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal recast response: %w", err)
+	}
+
+	return &result.Result, nil
+}
+
+// DeleteRecast undoes a recast of a given cast
+//
+// Parameters:
+//   - castHash: Hash of the recast cast
+//
+// Returns:
+//   - *StatusContent: Status of the delete operation
+//   - error: Any error that occurred
+func (w *Warpcast) DeleteRecast(castHash string) (*StatusContent, error) {
+	return w.DeleteRecastContext(context.Background(), castHash)
+}
+
+// DeleteRecastContext is like DeleteRecast but uses ctx for the request
+func (w *Warpcast) DeleteRecastContext(ctx context.Context, castHash string) (*StatusContent, error) {
+	body := RecastsDeleteRequest{
+		CastHash: castHash,
+	}
+
+	resp, err := w.request(ctx, "DELETE", "recasts", nil, body, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete recast: %w", err)
+	}
+
+	var result StatusResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal delete recast response: %w", err)
+	}
+
+	return &result.Result, nil
+}
+
+// CastRecastersResponse represents the API response for cast recasters
+type CastRecastersResponse struct {
+	Result struct {
+		Users []ApiUser `json:"users"`
+	} `json:"result"`
+	Next *struct {
+		Cursor string `json:"cursor"`
+	} `json:"next,omitempty"`
+}
+
+// GetCastRecasters retrieves the users who recast a given cast
+// Parameters:
+//   - castHash: Hash of the cast
+//   - cursor: Cursor to start from (optional)
+//   - limit: Number of users to retrieve (default 25, max 100)
+//
+// Returns:
+//   - *IterableUsersResult: A collection of users
+//   - error: Any error that occurred
+func (w *Warpcast) GetCastRecasters(castHash string, cursor *string, limit int) (*IterableUsersResult, error) {
+	return w.GetCastRecastersContext(context.Background(), castHash, cursor, limit)
+}
+
+// GetCastRecastersContext is like GetCastRecasters but uses ctx for every
+// request and stops paginating once ctx is done
+func (w *Warpcast) GetCastRecastersContext(ctx context.Context, castHash string, cursor *string, limit int) (*IterableUsersResult, error) {
+	if limit <= 0 {
+		limit = 25
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	pages := w.CastRecastersIter(ctx, castHash)
+	pages.SetCursor(cursor)
+
+	users, err := collect(pages, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cast recasters: %w", err)
+	}
+
+	return &IterableUsersResult{
+		Users:  users,
+		Cursor: pages.Cursor(),
+	}, nil
+}
+
+// CastRecastersIter returns a paginator over every user who recast a given
+// cast
+//
+// Parameters:
+//   - ctx: Context used for every page request
+//   - castHash: Hash of the cast
+//
+// Returns:
+//   - *Paginator[ApiUser]: A lazy paginator over the recasters
+func (w *Warpcast) CastRecastersIter(ctx context.Context, castHash string) *Paginator[ApiUser] {
+	return newPaginator(ctx, func(ctx context.Context, cursor *string, limit int) ([]ApiUser, *string, error) {
+		params := map[string]interface{}{
+			"castHash": castHash,
+			"cursor":   cursor,
+			"limit":    limit,
+		}
+
+		var response CastRecastersResponse
+		if err := w.get(ctx, "cast-recasters", params, &response); err != nil {
+			return nil, nil, err
+		}
+
+		var next *string
+		if response.Next != nil {
+			next = &response.Next.Cursor
+		}
+		return response.Result.Users, next, nil
+	}, nil)
+}
@@ -0,0 +1,134 @@
+package farcaster
+
+import (
+	"context"
+	"time"
+)
+
+// refreshRetryDelay is how long the background refresher waits after a
+// failed rotation before trying again
+const refreshRetryDelay = 10 * time.Second
+
+// WithBackgroundRefresh renews the access token in the background margin
+// before it expires, so requests never wait for a rotation. It only applies
+// to clients that can mint tokens, i.e. with a wallet or signer. Call Close
+// to stop the refresher
+func WithBackgroundRefresh(margin time.Duration) WarpcastOption {
+	return func(w *Warpcast) {
+		w.refreshMargin = margin
+	}
+}
+
+// WithRevokeOnClose makes Close revoke the access token with DeleteAuth
+func WithRevokeOnClose() WarpcastOption {
+	return func(w *Warpcast) {
+		w.revokeOnClose = true
+	}
+}
+
+// clock tells the time for token expiry and schedules the background
+// refresher, so that tests can control both
+type clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the clock backed by package time
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// withClock replaces the client's clock
+func withClock(c clock) WarpcastOption {
+	return func(w *Warpcast) {
+		w.clock = c
+	}
+}
+
+// startRefresher starts the background token refresher
+func (w *Warpcast) startRefresher() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.stopRefresher = cancel
+	w.refresherDone = make(chan struct{})
+
+	go func() {
+		defer close(w.refresherDone)
+
+		for {
+			wait, margin := w.nextRefresh()
+			if err := w.sleep(ctx, wait); err != nil {
+				return
+			}
+
+			if err := w.refreshToken(ctx, margin); err != nil {
+				// Requests still rotate lazily, so just try again shortly
+				if err := w.sleep(ctx, refreshRetryDelay); err != nil {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// nextRefresh returns how long to wait before the token enters the refresh
+// margin, and the margin to refresh with
+//
+// The margin is at most half of the lifetime the server actually granted, so
+// a token shorter lived than requested cannot keep the refresher rotating
+// without pause. The wait is never shorter than refreshRetryDelay
+func (w *Warpcast) nextRefresh() (time.Duration, time.Duration) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	margin := w.refreshMargin
+	if w.expiresAt == nil {
+		return refreshRetryDelay, margin
+	}
+
+	lifetime := time.Duration(*w.expiresAt-w.tokenSetAt) * time.Millisecond
+	if margin > lifetime/2 {
+		margin = lifetime / 2
+	}
+
+	wait := time.UnixMilli(*w.expiresAt).Add(-margin).Sub(w.clock.Now())
+	return max(wait, refreshRetryDelay), margin
+}
+
+// sleep waits for d on the client's clock or until ctx is done
+func (w *Warpcast) sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-w.clock.After(d):
+		return nil
+	}
+}
+
+// Close stops the background refresher and, if the client was created with
+// WithRevokeOnClose, revokes the access token unless it has already expired.
+// Close is safe to call more than once; later calls return the result of the
+// first
+//
+// Returns:
+//   - error: Any error that occurred while revoking the token
+func (w *Warpcast) Close() error {
+	w.closeOnce.Do(func() {
+		if w.stopRefresher != nil {
+			w.stopRefresher()
+			<-w.refresherDone
+		}
+
+		if w.revokeOnClose {
+			// Revoking an expired token would first mint a new one just to
+			// revoke it
+			expired, err := w.tokenExpiring(time.Second)
+			if err == nil && !expired {
+				if _, err := w.DeleteAuth(); err != nil {
+					w.closeErr = err
+				}
+			}
+		}
+	})
+	return w.closeErr
+}
@@ -0,0 +1,199 @@
+package farcaster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock that only moves when told to. Every call to After is
+// reported on waits so tests know when the refresher is asleep
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []fakeTimer
+	waits  chan time.Duration
+}
+
+type fakeTimer struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.UnixMilli(1700000000000), waits: make(chan time.Duration, 16)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	ch := make(chan time.Time, 1)
+	c.timers = append(c.timers, fakeTimer{at: c.now.Add(d), ch: ch})
+	c.mu.Unlock()
+
+	c.waits <- d
+	return ch
+}
+
+// Advance moves the clock forward by d and fires the timers that are due
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	pending := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.at.After(c.now) {
+			pending = append(pending, timer)
+			continue
+		}
+		timer.ch <- c.now
+	}
+	c.timers = pending
+}
+
+// nextWait returns the duration of the refresher's next sleep
+func (c *fakeClock) nextWait(t *testing.T) time.Duration {
+	t.Helper()
+	select {
+	case d := <-c.waits:
+		return d
+	case <-time.After(5 * time.Second):
+		t.Fatal("refresher did not go to sleep")
+		return 0
+	}
+}
+
+// newRefreshingClient returns a client with a background refresher whose
+// auth server grants tokens valid for lifetime on clk
+func newRefreshingClient(t *testing.T, clk *fakeClock, lifetime, margin time.Duration) (*Warpcast, *atomic.Int32) {
+	t.Helper()
+
+	var mints atomic.Int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := mints.Add(1)
+		fmt.Fprintf(w, `{"result":{"token":{"secret":"MK-%d","expiresAt":%d}}}`, n, clk.Now().Add(lifetime).UnixMilli())
+	}))
+	t.Cleanup(api.Close)
+
+	account, err := LocalAccountFromPrivateKey("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	if err != nil {
+		t.Fatalf("LocalAccountFromPrivateKey() failed: %v", err)
+	}
+
+	client, err := NewWarpcast(
+		WithWallet(account),
+		WithBasePath(api.URL+"/v2/"),
+		WithRotationDuration(10*time.Minute),
+		WithBackgroundRefresh(margin),
+		withClock(clk),
+	)
+	if err != nil {
+		t.Fatalf("NewWarpcast() failed: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client, &mints
+}
+
+func TestBackgroundRefresh(t *testing.T) {
+	clk := newFakeClock()
+	_, mints := newRefreshingClient(t, clk, 10*time.Minute, 2*time.Minute)
+
+	for i := int32(1); i <= 3; i++ {
+		wait := clk.nextWait(t)
+		if wait != 8*time.Minute {
+			t.Fatalf("refresher sleeps %v, want 8m", wait)
+		}
+		if got := mints.Load(); got != i {
+			t.Fatalf("minted %d tokens before refresh %d, want %d", got, i, i)
+		}
+		clk.Advance(wait + time.Millisecond)
+	}
+
+	clk.nextWait(t)
+	if got := mints.Load(); got != 4 {
+		t.Errorf("minted %d tokens, want 4", got)
+	}
+}
+
+func TestBackgroundRefreshShortLivedToken(t *testing.T) {
+	clk := newFakeClock()
+
+	// The server grants far less than the margin, let alone the 10m requested
+	_, mints := newRefreshingClient(t, clk, 2*time.Second, 5*time.Minute)
+
+	for i := int32(1); i <= 3; i++ {
+		wait := clk.nextWait(t)
+		if wait < refreshRetryDelay {
+			t.Fatalf("refresher sleeps %v, want at least %v", wait, refreshRetryDelay)
+		}
+		if got := mints.Load(); got != i {
+			t.Fatalf("minted %d tokens before refresh %d, want %d", got, i, i)
+		}
+		clk.Advance(wait)
+	}
+}
+
+func TestStoredTokenUsesClientClock(t *testing.T) {
+	clk := newFakeClock()
+
+	var mints atomic.Int32
+	var revokedAt atomic.Int64
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			mints.Add(1)
+			fmt.Fprintf(w, `{"result":{"token":{"secret":"MK-new","expiresAt":%d}}}`, clk.Now().Add(10*time.Minute).UnixMilli())
+		case "DELETE":
+			var body struct {
+				Params struct {
+					Timestamp int64 `json:"timestamp"`
+				} `json:"params"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			revokedAt.Store(body.Params.Timestamp)
+			w.Write([]byte(`{"result":{"success":true}}`))
+		}
+	}))
+	defer api.Close()
+
+	// Valid on the client's clock, long expired on the wall clock
+	store := NewMemoryTokenStore()
+	store.Save(context.Background(), &StoredToken{Secret: "MK-stored", ExpiresAt: clk.Now().Add(5 * time.Minute).UnixMilli()})
+
+	account, err := LocalAccountFromPrivateKey("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	if err != nil {
+		t.Fatalf("LocalAccountFromPrivateKey() failed: %v", err)
+	}
+	client, err := NewWarpcast(
+		WithWallet(account),
+		WithBasePath(api.URL+"/v2/"),
+		WithTokenStore(store),
+		withClock(clk),
+	)
+	if err != nil {
+		t.Fatalf("NewWarpcast() failed: %v", err)
+	}
+
+	if got := mints.Load(); got != 0 {
+		t.Errorf("minted %d tokens, want the stored token reused", got)
+	}
+
+	if _, err := client.DeleteAuth(); err != nil {
+		t.Fatalf("DeleteAuth() failed: %v", err)
+	}
+	if got, want := revokedAt.Load(), clk.Now().UnixMilli(); got != want {
+		t.Errorf("DeleteAuth() timestamp = %d, want %d", got, want)
+	}
+}
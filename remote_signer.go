@@ -0,0 +1,187 @@
+package farcaster
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RemoteSigner is a CustodySigner that delegates signing to a signing
+// service over HTTP, so that custody keys never enter this process
+//
+// The service is expected to answer two endpoints, both taking and returning
+// JSON with hex encoded byte strings:
+//
+//	POST {URL}/sign-hash              {"address": "0x..", "hash": "0x.."}    -> {"signature": "0x.."}
+//	POST {URL}/sign-personal-message  {"address": "0x..", "message": "0x.."} -> {"signature": "0x.."}
+//
+// NewSignerHandler implements the service side on top of any CustodySigner
+type RemoteSigner struct {
+	// URL is the base URL of the signing service
+	URL string
+	// Address is the custody address the service signs for
+	Address string
+	// Headers are added to every request, e.g. for authentication
+	Headers map[string]string
+	// Client is the HTTP client to use, http.DefaultClient if nil
+	Client *http.Client
+}
+
+// maxErrorBodyLength is the number of bytes of an unexpected response body
+// included in errors
+const maxErrorBodyLength = 200
+
+// signRequest represents the request body of the signing service
+type signRequest struct {
+	Address string `json:"address"`
+	Hash    string `json:"hash,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// signResponse represents the response body of the signing service
+type signResponse struct {
+	Signature string `json:"signature"`
+	Error     string `json:"error,omitempty"`
+}
+
+// CustodyAddress returns the address the service signs for
+func (s *RemoteSigner) CustodyAddress() string {
+	return s.Address
+}
+
+// SignHash asks the service to sign a 32 byte hash
+func (s *RemoteSigner) SignHash(ctx context.Context, hash []byte) ([]byte, error) {
+	return s.sign(ctx, "sign-hash", signRequest{Address: s.Address, Hash: hexutil.Encode(hash)})
+}
+
+// SignPersonalMessage asks the service to sign message as an EIP-191
+// personal message
+func (s *RemoteSigner) SignPersonalMessage(ctx context.Context, message []byte) ([]byte, error) {
+	return s.sign(ctx, "sign-personal-message", signRequest{Address: s.Address, Message: hexutil.Encode(message)})
+}
+
+// sign posts body to the given endpoint of the service and decodes the
+// returned signature
+func (s *RemoteSigner) sign(ctx context.Context, endpoint string, body signRequest) ([]byte, error) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sign request: %w", err)
+	}
+
+	url := strings.TrimSuffix(s.URL, "/") + "/" + endpoint
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sign request: %w", err)
+	}
+	for k, v := range s.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sign request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sign response: %w", err)
+	}
+
+	var result signResponse
+	if resp.StatusCode != http.StatusOK {
+		// Proxies in front of the service may answer with HTML or plain text
+		message := truncate(strings.TrimSpace(string(respBody)), maxErrorBodyLength)
+		if json.Unmarshal(respBody, &result) == nil && result.Error != "" {
+			message = result.Error
+		}
+		return nil, fmt.Errorf("signing service error: %d %s", resp.StatusCode, message)
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sign response: %w", err)
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("signing service error: %d %s", resp.StatusCode, result.Error)
+	}
+
+	signature, err := hexutil.Decode(result.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from signing service: %w", err)
+	}
+	return signature, nil
+}
+
+// truncate shortens s to at most n bytes, marking the cut
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
+
+// NewSignerHandler returns an http.Handler that serves the RemoteSigner
+// protocol by signing with signer
+//
+// It performs no authentication of its own, so it should be wrapped or only
+// exposed on a trusted network
+func NewSignerHandler(signer CustodySigner) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /sign-hash", func(w http.ResponseWriter, r *http.Request) {
+		serveSign(w, r, signer, func(req signRequest) ([]byte, error) {
+			hash, err := hexutil.Decode(req.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("invalid hash: %w", err)
+			}
+			return signer.SignHash(r.Context(), hash)
+		})
+	})
+	mux.HandleFunc("POST /sign-personal-message", func(w http.ResponseWriter, r *http.Request) {
+		serveSign(w, r, signer, func(req signRequest) ([]byte, error) {
+			message, err := hexutil.Decode(req.Message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid message: %w", err)
+			}
+			return signer.SignPersonalMessage(r.Context(), message)
+		})
+	})
+	return mux
+}
+
+// serveSign decodes a sign request, checks its address and writes the
+// signature produced by sign
+func serveSign(w http.ResponseWriter, r *http.Request, signer CustodySigner, sign func(signRequest) ([]byte, error)) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var req signRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(signResponse{Error: "invalid request body"})
+		return
+	}
+	if !strings.EqualFold(req.Address, signer.CustodyAddress()) {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(signResponse{Error: "unknown address"})
+		return
+	}
+
+	signature, err := sign(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(signResponse{Error: err.Error()})
+		return
+	}
+
+	json.NewEncoder(w).Encode(signResponse{Signature: hexutil.Encode(signature)})
+}
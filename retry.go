@@ -0,0 +1,193 @@
+package farcaster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures how failed requests are retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per request, including the
+	// first one. Values below 1 disable retries
+	MaxAttempts int
+	// InitialBackoff is the base delay before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential backoff delay. A request whose
+	// Retry-After asks for a longer wait fails with the *APIError instead of
+	// sleeping. Zero means the MaxBackoff of DefaultRetryPolicy
+	MaxBackoff time.Duration
+	// RetryNonIdempotent allows retrying methods such as POST, which may
+	// otherwise be applied twice
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy is the retry policy used by NewWarpcast
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+}
+
+// NoRetryPolicy disables retries
+var NoRetryPolicy = RetryPolicy{MaxAttempts: 1}
+
+// WithRetryPolicy sets the retry policy for the client
+func WithRetryPolicy(policy RetryPolicy) WarpcastOption {
+	return func(w *Warpcast) {
+		w.retryPolicy = policy
+	}
+}
+
+// attemptsFor returns the number of attempts allowed for method
+func (p RetryPolicy) attemptsFor(method string) int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(method) {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// maxBackoff returns the longest delay the policy waits before a retry
+func (p RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff > 0 {
+		return p.MaxBackoff
+	}
+	return DefaultRetryPolicy.MaxBackoff
+}
+
+// backoff returns the delay before the given retry, honoring Retry-After when
+// the previous error carried one. It reports false if Retry-After asks for a
+// longer wait than the policy allows
+func (p RetryPolicy) backoff(retry int, lastErr error) (time.Duration, bool) {
+	maxBackoff := p.maxBackoff()
+
+	var apiErr *APIError
+	if errors.As(lastErr, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter, apiErr.RetryAfter <= maxBackoff
+	}
+
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	if delay <= 0 {
+		return 0, true
+	}
+
+	// Equal jitter keeps at least half of the delay while spreading retries
+	half := delay / 2
+	return half + time.Duration(rand.Int64N(int64(delay-half)+1)), true
+}
+
+// isIdempotent reports whether requests with method can safely be repeated
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryable reports whether a request that failed with err should be retried
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+			http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	// Anything else is a transport failure
+	return true
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// doWithRetry sends the request built by newRequest and returns the response
+// body, retrying transient failures according to the client's retry policy
+//
+// newRequest is called once per attempt so that request bodies can be re-read
+func (w *Warpcast) doWithRetry(ctx context.Context, method, path string, newRequest func() (*http.Request, error)) ([]byte, error) {
+	attempts := w.retryPolicy.attemptsFor(method)
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			delay, ok := w.retryPolicy.backoff(attempt, lastErr)
+			if !ok {
+				break
+			}
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, err
+			}
+		}
+
+		req, err := newRequest()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		body, err := w.doOnce(req, method, path)
+		if err == nil {
+			return body, nil
+		}
+
+		lastErr = err
+		if !isRetryable(ctx, err) {
+			break
+		}
+	}
+
+	return nil, lastErr
+}
+
+// doOnce sends req and returns the response body, or an error if the request
+// failed or the API reported one
+func (w *Warpcast) doOnce(req *http.Request, method, path string) ([]byte, error) {
+	resp, err := w.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Read response
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	// Check for API errors
+	if err := checkResponse(resp, method, path, respBody); err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
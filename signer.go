@@ -0,0 +1,81 @@
+package farcaster
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// CustodySigner signs on behalf of a custody address
+//
+// Signatures are 65 bytes in [R || S || V] form. SignHash returns V as 0 or 1
+// like go-ethereum's crypto.Sign, SignPersonalMessage returns V as 27 or 28
+// like personal_sign
+type CustodySigner interface {
+	// CustodyAddress returns the checksummed address of the signer
+	CustodyAddress() string
+	// SignHash signs a 32 byte hash
+	SignHash(ctx context.Context, hash []byte) ([]byte, error)
+	// SignPersonalMessage signs message as an EIP-191 personal message
+	SignPersonalMessage(ctx context.Context, message []byte) ([]byte, error)
+}
+
+// WithSigner sets the signer used for custody auth, e.g. a RemoteSigner that
+// keeps the custody key out of process
+func WithSigner(signer CustodySigner) WarpcastOption {
+	return func(w *Warpcast) {
+		w.signer = signer
+	}
+}
+
+// CustodyAddress returns the address of the account
+func (a *LocalAccount) CustodyAddress() string {
+	if a.Address != "" {
+		return a.Address
+	}
+	key, err := a.privateKey()
+	if err != nil {
+		return ""
+	}
+	return crypto.PubkeyToAddress(key.PublicKey).Hex()
+}
+
+// SignHash signs a 32 byte hash with the account's private key
+func (a *LocalAccount) SignHash(ctx context.Context, hash []byte) ([]byte, error) {
+	key, err := a.privateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(hash, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign hash: %w", err)
+	}
+	return signature, nil
+}
+
+// SignPersonalMessage signs message as an EIP-191 personal message with the
+// account's private key
+func (a *LocalAccount) SignPersonalMessage(ctx context.Context, message []byte) ([]byte, error) {
+	signature, err := a.SignHash(ctx, accounts.TextHash(message))
+	if err != nil {
+		return nil, err
+	}
+
+	// personal_sign signatures carry a recovery id of 27 or 28
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// privateKey parses the account's hex encoded private key
+func (a *LocalAccount) privateKey() (*ecdsa.PrivateKey, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(a.PrivateKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,194 @@
+package farcaster
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// StreamFunc fetches the newest items of a list endpoint, newest first
+type StreamFunc[T any] func(ctx context.Context, limit int) ([]T, error)
+
+// StreamOptions configures a Stream
+type StreamOptions struct {
+	// PauseAfter ends an iteration after this many consecutive polls without
+	// new items. A negative value ends it after every poll and nil never
+	// pauses. Ranging over All again resumes the stream
+	PauseAfter *int
+	// SkipExisting drops the items returned by the first poll
+	SkipExisting bool
+	// MaxCounter caps the backoff between empty polls, in seconds (default 16)
+	MaxCounter int
+	// Limit is the number of items requested per poll (default 100)
+	Limit int
+	// SeenPath optionally persists the attributes of yielded items to a
+	// file, so that a restarted stream does not replay them
+	SeenPath string
+}
+
+// Stream polls a list endpoint and yields items it has not seen before
+//
+// A Stream is not safe for concurrent use
+type Stream[T any] struct {
+	function     StreamFunc[T]
+	key          func(T) string
+	pauseAfter   *int
+	skipExisting bool
+	limit        int
+	seenPath     string
+
+	exponentialCounter   *ExponentialCounter
+	seenAttributes       *BoundedSet
+	beforeAttribute      string
+	withoutBeforeCounter int
+	responsesWithoutNew  int
+}
+
+// NewStream creates a stream over function, deduplicating items by the
+// attribute returned by key (e.g. a cast hash or user FID)
+func NewStream[T any](function StreamFunc[T], key func(T) string, opts StreamOptions) *Stream[T] {
+	if opts.MaxCounter <= 0 {
+		opts.MaxCounter = 16
+	}
+	if opts.Limit <= 0 || opts.Limit > maxPageSize {
+		opts.Limit = maxPageSize
+	}
+
+	return &Stream[T]{
+		function:           function,
+		key:                key,
+		pauseAfter:         opts.PauseAfter,
+		skipExisting:       opts.SkipExisting,
+		limit:              opts.Limit,
+		seenPath:           opts.SeenPath,
+		exponentialCounter: &ExponentialCounter{maxCounter: opts.MaxCounter},
+	}
+}
+
+// Evictions returns the number of attributes dropped from the dedupe set.
+// A steadily growing count means the set is small compared to the poll rate
+func (s *Stream[T]) Evictions() int {
+	if s.seenAttributes == nil {
+		return 0
+	}
+	return s.seenAttributes.Evictions()
+}
+
+// All returns an iterator over new items, oldest first
+//
+// Polling continues until the consumer stops ranging, ctx is done or the
+// stream pauses as configured by PauseAfter. Errors from function are yielded
+// with the zero value; the stream keeps polling with backoff if the consumer
+// continues
+func (s *Stream[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if s.seenAttributes == nil {
+			seen, err := s.loadSeen()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			s.seenAttributes = seen
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			found := false
+			var newestAttribute string
+			dynamicLimit := s.limit
+
+			// Vary the limit while we have no anchor so that polls are not
+			// served from a cache
+			if s.beforeAttribute == "" && s.limit > 1 {
+				dynamicLimit -= s.withoutBeforeCounter
+				s.withoutBeforeCounter = (s.withoutBeforeCounter + 1) % (s.limit / 2)
+			}
+
+			items, err := s.function(ctx, dynamicLimit)
+			if err != nil {
+				if !yield(zero, err) {
+					return
+				}
+				if err := sleepContext(ctx, s.backoff()); err != nil {
+					yield(zero, err)
+					return
+				}
+				continue
+			}
+
+			for i := len(items) - 1; i >= 0; i-- {
+				item := items[i]
+				attribute := s.key(item)
+				if s.seenAttributes.Contains(attribute) {
+					continue
+				}
+				found = true
+				s.seenAttributes.Add(attribute)
+				newestAttribute = attribute
+				if !s.skipExisting {
+					if !yield(item, nil) {
+						// The consumer is gone, so a failed save can only be dropped
+						_ = s.persist()
+						return
+					}
+				}
+			}
+
+			s.beforeAttribute = newestAttribute
+			s.skipExisting = false
+
+			if found {
+				if err := s.persist(); err != nil {
+					if !yield(zero, err) {
+						return
+					}
+				}
+			}
+
+			if s.pauseAfter != nil && *s.pauseAfter < 0 {
+				return
+			} else if found {
+				s.exponentialCounter.reset()
+				s.responsesWithoutNew = 0
+			} else {
+				s.responsesWithoutNew++
+				if s.pauseAfter != nil && s.responsesWithoutNew > *s.pauseAfter {
+					s.exponentialCounter.reset()
+					s.responsesWithoutNew = 0
+					return
+				}
+				if err := sleepContext(ctx, s.backoff()); err != nil {
+					yield(zero, err)
+					return
+				}
+			}
+		}
+	}
+}
+
+// loadSeen creates the dedupe set, restoring it from seenPath if set
+func (s *Stream[T]) loadSeen() (*BoundedSet, error) {
+	size := 3*s.limit + 1
+	if s.seenPath == "" {
+		return NewBoundedSet(size), nil
+	}
+	return LoadBoundedSet(s.seenPath, size)
+}
+
+// persist saves the dedupe set to seenPath if set
+func (s *Stream[T]) persist() error {
+	if s.seenPath == "" {
+		return nil
+	}
+	return s.seenAttributes.Save(s.seenPath)
+}
+
+// backoff returns the delay before the next poll
+func (s *Stream[T]) backoff() time.Duration {
+	return time.Duration(s.exponentialCounter.counterFunction()) * time.Second
+}
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+func TestBoundedSetEvictsLeastRecentlyUsed(t *testing.T) {
+	set := farcaster.NewBoundedSet(2)
+	set.Add("a")
+	set.Add("b")
+
+	// Touch "a" so that "b" becomes the oldest
+	if !set.Contains("a") {
+		t.Fatal("Contains(a) = false, want true")
+	}
+	set.Add("c")
+
+	if set.Contains("b") {
+		t.Error("Contains(b) = true after eviction")
+	}
+	if !set.Contains("a") || !set.Contains("c") {
+		t.Error("recently used attributes were evicted")
+	}
+	if set.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", set.Len())
+	}
+	if set.Evictions() != 1 {
+		t.Errorf("Evictions() = %d, want 1", set.Evictions())
+	}
+}
+
+func TestBoundedSetPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "seen")
+
+	empty, err := farcaster.LoadBoundedSet(path, 3)
+	if err != nil {
+		t.Fatalf("LoadBoundedSet() on missing file failed: %v", err)
+	}
+	if empty.Len() != 0 {
+		t.Fatalf("Len() = %d, want 0", empty.Len())
+	}
+
+	set := farcaster.NewBoundedSet(3)
+	for _, attribute := range []string{"a", "b", "c", "d"} {
+		set.Add(attribute)
+	}
+	if err := set.Save(path); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	// A smaller set keeps the most recent attributes
+	loaded, err := farcaster.LoadBoundedSet(path, 2)
+	if err != nil {
+		t.Fatalf("LoadBoundedSet() failed: %v", err)
+	}
+	if loaded.Contains("b") || !loaded.Contains("c") || !loaded.Contains("d") {
+		t.Errorf("loaded set has wrong attributes")
+	}
+}
@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestCastLikes(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.Method + " " + r.URL.Path {
+		case "DELETE /v2/cast-likes":
+			w.Write([]byte(`{"result":{"success":true}}`))
+		case "GET /v2/cast-likes":
+			if query.Get("castHash") != "0xabc" {
+				t.Errorf("cast-likes castHash = %q", query.Get("castHash"))
+			}
+			if query.Get("cursor") == "" {
+				w.Write([]byte(`{"result":{"likes":[{"type":"like","hash":"0x1","reactor":{"fid":2},"timestamp":1700000000000,"castHash":"0xabc"}]},"next":{"cursor":"c1"}}`))
+				return
+			}
+			w.Write([]byte(`{"result":{"likes":[{"type":"like","hash":"0x2","reactor":{"fid":3},"timestamp":1690000000000,"castHash":"0xabc"}]}}`))
+		case "GET /v2/user-cast-likes":
+			if query.Get("fid") != "3" {
+				t.Errorf("user-cast-likes fid = %q", query.Get("fid"))
+			}
+			w.Write([]byte(`{"result":{"likes":[{"type":"like","hash":"0x3","reactor":{"fid":3},"timestamp":1,"castHash":"0xdef","cast":{"hash":"0xdef","text":"liked"}}]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	status, err := client.DeleteCastLike("0xabc")
+	if err != nil {
+		t.Fatalf("DeleteCastLike() failed: %v", err)
+	}
+	if !status.Success {
+		t.Error("DeleteCastLike() success = false")
+	}
+
+	likes, err := client.GetCastLikes("0xabc", nil, 1)
+	if err != nil {
+		t.Fatalf("GetCastLikes() failed: %v", err)
+	}
+	if len(likes.Likes) != 1 || likes.Likes[0].Reactor.FID != 2 || likes.Likes[0].Timestamp != 1700000000000 {
+		t.Errorf("GetCastLikes() = %+v", likes)
+	}
+	if likes.Cursor == nil || *likes.Cursor != "c1" {
+		t.Errorf("GetCastLikes() cursor = %v, want c1", likes.Cursor)
+	}
+
+	var reactors []int
+	for like, err := range client.CastLikesIter(context.Background(), "0xabc").All() {
+		if err != nil {
+			t.Fatalf("CastLikesIter() yielded error: %v", err)
+		}
+		reactors = append(reactors, like.Reactor.FID)
+	}
+	if len(reactors) != 2 || reactors[1] != 3 {
+		t.Errorf("CastLikesIter() reactors = %v", reactors)
+	}
+
+	userLikes, err := client.GetUserCastLikes(3, nil, 0)
+	if err != nil {
+		t.Fatalf("GetUserCastLikes() failed: %v", err)
+	}
+	if len(userLikes.Likes) != 1 || userLikes.Likes[0].Cast == nil || userLikes.Likes[0].Cast.Text != "liked" {
+		t.Errorf("GetUserCastLikes() = %+v", userLikes)
+	}
+}
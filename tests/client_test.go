@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+// newTestClient starts a server for handler and returns a client pointed at it
+func newTestClient(t *testing.T, handler http.Handler, opts ...farcaster.WarpcastOption) *farcaster.Warpcast {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	opts = append([]farcaster.WarpcastOption{
+		farcaster.WithAccessToken("test-token", nil),
+		farcaster.WithBasePath(server.URL + "/v2/"),
+	}, opts...)
+
+	client, err := farcaster.NewWarpcast(opts...)
+	if err != nil {
+		t.Fatalf("NewWarpcast() failed: %v", err)
+	}
+	return client
+}
+
+func TestEndpointsShareRequestCore(t *testing.T) {
+	var mu sync.Mutex
+	authByPath := make(map[string]string)
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		authByPath[r.URL.Path] = r.Header.Get("Authorization")
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/v2/me":
+			w.Write([]byte(`{"result":{"user":{"fid":3,"username":"dwr"}}}`))
+		case "/v2/casts":
+			w.Write([]byte(`{"result":{"casts":[{"hash":"0x1","author":{"fid":3},"text":"hi"}]}}`))
+		default:
+			w.Write([]byte(`{"result":{"users":[{"fid":2}]}}`))
+		}
+	}))
+
+	if _, err := client.GetCasts(3, nil, 1); err != nil {
+		t.Fatalf("GetCasts() failed: %v", err)
+	}
+	if _, err := client.GetFollowers(3, nil, 1); err != nil {
+		t.Fatalf("GetFollowers() failed: %v", err)
+	}
+	if _, err := client.GetFollowing(nil); err != nil {
+		t.Fatalf("GetFollowing() failed: %v", err)
+	}
+
+	for _, path := range []string{"/v2/casts", "/v2/followers", "/v2/me", "/v2/following"} {
+		if got := authByPath[path]; got != "Bearer test-token" {
+			t.Errorf("%s: Authorization = %q, want %q", path, got, "Bearer test-token")
+		}
+	}
+}
+
+func TestHealthcheckUsesRequestCore(t *testing.T) {
+	var attempts atomic.Int32
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthcheck" {
+			http.NotFound(w, r)
+			return
+		}
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"success":true}`))
+	}), farcaster.WithRetryPolicy(farcaster.RetryPolicy{
+		MaxAttempts:    2,
+		InitialBackoff: time.Millisecond,
+	}))
+
+	healthy, err := client.GetHealthcheck()
+	if err != nil || !healthy {
+		t.Fatalf("GetHealthcheck() = %v, %v, want true", healthy, err)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("server saw %d attempts, want 2", got)
+	}
+}
+
+func TestDeprecatedClientFields(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("request %s went to the WithBasePath server", r.URL.Path)
+	}))
+
+	var viaClient atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"result":{"cast":{"hash":"0x1"}}}`))
+	}))
+	defer server.Close()
+
+	httpClient := server.Client()
+	httpClient.Transport = roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		viaClient.Store(true)
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	client.BaseURL = server.URL + "/v2"
+	client.HTTPClient = httpClient
+
+	if _, err := client.GetCast("0x1"); err != nil {
+		t.Fatalf("GetCast() failed: %v", err)
+	}
+	if !viaClient.Load() {
+		t.Error("GetCast() did not use the HTTPClient field")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestAssetAndCollectionEndpoints(t *testing.T) {
+	const asset = `{"id":"a1","tokenId":"42","name":"Merge #42","owner":{"fid":3},"collection":{"id":"proof-of-merge","name":"Proof of Merge","itemCount":100,"ownerCount":80,"farcasterOwnerCount":12}}`
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/v2/asset":
+			w.Write([]byte(`{"result":{"asset":` + asset + `}}`))
+		case "/v2/asset-events":
+			w.Write([]byte(`{"result":{"events":[{"id":"e1","timestamp":1700000000000,"eventType":1,"asset":` + asset + `,"transactionHash":"0xff"}]},"next":{"cursor":"c1"}}`))
+		case "/v2/user-collections":
+			if query.Get("ownerFid") != "2" {
+				t.Errorf("user-collections ownerFid = %q, want 2", query.Get("ownerFid"))
+			}
+			if query.Get("cursor") == "" {
+				w.Write([]byte(`{"result":{"collections":[{"id":"c1","name":"One"}]},"next":{"cursor":"p2"}}`))
+				return
+			}
+			w.Write([]byte(`{"result":{"collections":[{"id":"c2","name":"Two"}]}}`))
+		case "/v2/collection-owners":
+			if query.Get("collectionId") != "proof-of-merge" {
+				t.Errorf("collection-owners collectionId = %q", query.Get("collectionId"))
+			}
+			w.Write([]byte(`{"result":{"users":[{"fid":3},{"fid":5}]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	result, err := client.GetAsset(42)
+	if err != nil {
+		t.Fatalf("GetAsset() failed: %v", err)
+	}
+	if result.Asset.TokenID != "42" || result.Asset.Owner == nil || result.Asset.Collection.FarcasterOwnerCount != 12 {
+		t.Errorf("GetAsset() = %+v", result.Asset)
+	}
+
+	events, err := client.GetAssetEvents(nil, 10)
+	if err != nil {
+		t.Fatalf("GetAssetEvents() failed: %v", err)
+	}
+	if len(events.Events) != 1 || events.Events[0].EventType != 1 || events.Events[0].Asset.Collection.ID != "proof-of-merge" {
+		t.Errorf("GetAssetEvents() = %+v", events)
+	}
+
+	var ids []string
+	for collection, err := range client.UserCollectionsIter(context.Background(), 2).All() {
+		if err != nil {
+			t.Fatalf("UserCollectionsIter() yielded error: %v", err)
+		}
+		ids = append(ids, collection.ID)
+	}
+	if len(ids) != 2 || ids[1] != "c2" {
+		t.Errorf("UserCollectionsIter() yielded %v", ids)
+	}
+
+	collections, err := client.GetUserCollections(2, nil, 1)
+	if err != nil {
+		t.Fatalf("GetUserCollections() failed: %v", err)
+	}
+	if len(collections.Collections) != 1 || collections.Cursor == nil || *collections.Cursor != "p2" {
+		t.Errorf("GetUserCollections() = %+v", collections)
+	}
+
+	owners, err := client.GetCollectionOwners("proof-of-merge", nil, 0)
+	if err != nil {
+		t.Fatalf("GetCollectionOwners() failed: %v", err)
+	}
+	if len(owners.Users) != 2 || owners.Cursor != nil {
+		t.Errorf("GetCollectionOwners() = %+v", owners)
+	}
+}
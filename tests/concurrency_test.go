@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+// Run with -race to catch unsynchronised access to the token state
+func TestConcurrentTokenRotation(t *testing.T) {
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+
+	var mints, staleRequests atomic.Int32
+	auth := authServer(t, account.Address)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/auth" {
+			mints.Add(1)
+			auth.ServeHTTP(w, r)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer MK-minted" {
+			staleRequests.Add(1)
+		}
+		w.Write([]byte(`{"result":{"cast":{"hash":"0x1"}}}`))
+	}))
+	defer api.Close()
+
+	// Start from a token that has already expired
+	expired := int64(1)
+	client, err := farcaster.NewWarpcast(
+		farcaster.WithAccessToken("expired", &expired),
+		farcaster.WithWallet(account),
+		farcaster.WithBasePath(api.URL+"/v2/"),
+	)
+	if err != nil {
+		t.Fatalf("NewWarpcast() failed: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetCast("0x1"); err != nil {
+				t.Errorf("GetCast() failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := mints.Load(); got != 1 {
+		t.Errorf("minted %d tokens, want 1", got)
+	}
+	if got := staleRequests.Load(); got != 0 {
+		t.Errorf("%d requests used the expired token", got)
+	}
+}
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestCustodyAuthHeader(t *testing.T) {
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+
+	var header string
+	var params farcaster.AuthParams
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("Authorization")
+		var body farcaster.AuthPutRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Params == nil {
+			t.Errorf("failed to decode auth request: %v", err)
+		} else {
+			params = *body.Params
+		}
+		w.Write([]byte(`{"result":{"token":{"secret":"MK-minted","expiresAt":33228645430000}}}`))
+	}))
+	defer api.Close()
+
+	if _, err := farcaster.NewWarpcast(
+		farcaster.WithWallet(account),
+		farcaster.WithBasePath(api.URL+"/v2/"),
+	); err != nil {
+		t.Fatalf("NewWarpcast() failed: %v", err)
+	}
+
+	encoded, ok := strings.CutPrefix(header, "Bearer eip191:")
+	if !ok {
+		t.Fatalf("Authorization = %q, want Bearer eip191: prefix", header)
+	}
+	signature, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("signature is not base64: %v", err)
+	}
+	if len(signature) != 65 || (signature[64] != 27 && signature[64] != 28) {
+		t.Fatalf("signature = %x, want 65 bytes with V of 27 or 28", signature)
+	}
+
+	// The signed payload must be the canonical JSON with sorted keys
+	payload := fmt.Sprintf(`{"method":"generateToken","params":{"expiresAt":%d,"timestamp":%d}}`, params.ExpiresAt, params.Timestamp)
+	sig := append([]byte(nil), signature...)
+	sig[64] -= 27
+	publicKey, err := crypto.SigToPub(accounts.TextHash([]byte(payload)), sig)
+	if err != nil {
+		t.Fatalf("failed to recover public key: %v", err)
+	}
+	if address := crypto.PubkeyToAddress(*publicKey).Hex(); address != account.Address {
+		t.Errorf("canonical payload recovers %s, want %s", address, account.Address)
+	}
+
+	address, err := farcaster.RecoverCustodyAddress(header, &params)
+	if err != nil {
+		t.Fatalf("RecoverCustodyAddress() failed: %v", err)
+	}
+	if address != account.Address {
+		t.Errorf("RecoverCustodyAddress() = %s, want %s", address, account.Address)
+	}
+
+	tampered := params
+	tampered.ExpiresAt++
+	address, err = farcaster.RecoverCustodyAddress(header, &tampered)
+	if err == nil && address == account.Address {
+		t.Error("RecoverCustodyAddress() recovered the signer for a tampered payload")
+	}
+
+	if _, err := farcaster.RecoverCustodyAddress("Bearer "+encoded, &params); err == nil {
+		t.Error("RecoverCustodyAddress() accepted a header without the eip191: prefix")
+	}
+}
+
+// bufferSigner returns signatures with a raw 0/1 recovery id in a buffer it
+// reuses across calls
+type bufferSigner struct {
+	*farcaster.LocalAccount
+	buf []byte
+}
+
+func (s *bufferSigner) SignPersonalMessage(ctx context.Context, message []byte) ([]byte, error) {
+	signature, err := s.LocalAccount.SignPersonalMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+	s.buf = append(s.buf[:0], signature...)
+	s.buf[64] -= 27
+	return s.buf, nil
+}
+
+func TestCustodyAuthHeaderKeepsSignerBuffer(t *testing.T) {
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+	signer := &bufferSigner{LocalAccount: account}
+
+	api := httptest.NewServer(authServer(t, account.Address))
+	defer api.Close()
+
+	if _, err := farcaster.NewWarpcast(
+		farcaster.WithSigner(signer),
+		farcaster.WithBasePath(api.URL+"/v2/"),
+	); err != nil {
+		t.Fatalf("NewWarpcast() failed: %v", err)
+	}
+
+	if v := signer.buf[64]; v > 1 {
+		t.Errorf("signer buffer V = %d, want the raw recovery id left untouched", v)
+	}
+}
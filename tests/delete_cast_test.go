@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+func TestDeleteThreadCasts(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/me":
+			w.Write([]byte(`{"result":{"user":{"fid":3,"username":"me"}}}`))
+		case "GET /v2/all-casts-in-thread":
+			w.Write([]byte(`{"result":{"casts":[
+				{"hash":"0x1","author":{"fid":3}},
+				{"hash":"0x2","author":{"fid":5}},
+				{"hash":"0x3","author":{"fid":3}},
+				{"hash":"0x4","author":{"fid":3}}
+			]}}`))
+		case "DELETE /v2/casts":
+			var body farcaster.CastsDeleteRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("DELETE casts body: %v", err)
+			}
+			if body.CastHash == "0x3" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"errors":[{"message":"cannot delete"}]}`))
+				return
+			}
+			mu.Lock()
+			deleted = append(deleted, body.CastHash)
+			mu.Unlock()
+			w.Write([]byte(`{"result":{"success":true}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	results, err := client.DeleteThreadCasts("0x1")
+	if err != nil {
+		t.Fatalf("DeleteThreadCasts() failed: %v", err)
+	}
+
+	want := []string{"0x4", "0x3", "0x1"}
+	if len(results) != len(want) {
+		t.Fatalf("DeleteThreadCasts() returned %d results, want %d", len(results), len(want))
+	}
+	for i, result := range results {
+		if result.CastHash != want[i] {
+			t.Errorf("result %d cast = %s, want %s", i, result.CastHash, want[i])
+		}
+		if (result.Err != nil) != (result.CastHash == "0x3") {
+			t.Errorf("result %d error = %v", i, result.Err)
+		}
+	}
+	if len(deleted) != 2 || deleted[0] != "0x4" || deleted[1] != "0x1" {
+		t.Errorf("deleted casts = %v, want [0x4 0x1]", deleted)
+	}
+
+	status, err := client.DeleteCast("0x2")
+	if err != nil || !status.Success {
+		t.Errorf("DeleteCast() = %+v, %v", status, err)
+	}
+}
+
+func TestDeleteThreadCastsRepliesFirst(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/me":
+			w.Write([]byte(`{"result":{"user":{"fid":3,"username":"me"}}}`))
+		case "GET /v2/all-casts-in-thread":
+			// Listed neither root first nor by timestamp
+			w.Write([]byte(`{"result":{"casts":[
+				{"hash":"0x3","parentHash":"0x2","timestamp":1,"author":{"fid":3}},
+				{"hash":"0x1","timestamp":4,"author":{"fid":3}},
+				{"hash":"0x4","parentHash":"0x1","timestamp":2,"author":{"fid":3}},
+				{"hash":"0x2","parentHash":"0x1","timestamp":3,"author":{"fid":5}},
+				{"hash":"0x5","parentHash":"0x1","timestamp":5,"author":{"fid":3}}
+			]}}`))
+		case "DELETE /v2/casts":
+			var body farcaster.CastsDeleteRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("DELETE casts body: %v", err)
+			}
+			mu.Lock()
+			deleted = append(deleted, body.CastHash)
+			mu.Unlock()
+			w.Write([]byte(`{"result":{"success":true}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	if _, err := client.DeleteThreadCasts("0x1"); err != nil {
+		t.Fatalf("DeleteThreadCasts() failed: %v", err)
+	}
+
+	want := []string{"0x3", "0x5", "0x4", "0x1"}
+	if len(deleted) != len(want) {
+		t.Fatalf("deleted casts = %v, want %v", deleted, want)
+	}
+	for i := range want {
+		if deleted[i] != want[i] {
+			t.Fatalf("deleted casts = %v, want %v", deleted, want)
+		}
+	}
+}
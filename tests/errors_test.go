@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+func TestAPIError(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"message":"cast not found"}]}`))
+	}))
+
+	_, err := client.GetCast("0xdead")
+	if !farcaster.IsNotFound(err) {
+		t.Fatalf("GetCast() error = %v, want not found", err)
+	}
+
+	var apiErr *farcaster.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("GetCast() error is not an *APIError")
+	}
+	if apiErr.Method != "GET" || apiErr.Path != "cast" {
+		t.Errorf("APIError request = %s %s, want GET cast", apiErr.Method, apiErr.Path)
+	}
+	if len(apiErr.Messages) != 1 || apiErr.Messages[0] != "cast not found" {
+		t.Errorf("APIError.Messages = %v", apiErr.Messages)
+	}
+}
+
+func TestAPIErrorStatus(t *testing.T) {
+	tests := []struct {
+		body         string
+		status       int
+		wantErr      bool
+		notFound     bool
+		rateLimited  bool
+		unauthorized bool
+	}{
+		{body: `{"result":{"cast":{"hash":"0x1"}}}`, status: http.StatusOK},
+		{body: `{"result":{"cast":{"hash":"0x1"}},"errors":[]}`, status: http.StatusOK},
+		{body: `{"errors":[{"message":"bad request"}]}`, status: http.StatusOK, wantErr: true},
+		{body: `{"errors":[{"message":"missing"}]}`, status: http.StatusNotFound, wantErr: true, notFound: true},
+		{body: `{"errors":[{"message":"slow down"}]}`, status: http.StatusTooManyRequests, wantErr: true, rateLimited: true},
+		{body: `not json`, status: http.StatusUnauthorized, wantErr: true, unauthorized: true},
+	}
+
+	for _, tt := range tests {
+		client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+			w.Write([]byte(tt.body))
+		}), farcaster.WithRetryPolicy(farcaster.NoRetryPolicy))
+
+		_, err := client.GetCast("0x1")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%d %s: error = %v, wantErr %v", tt.status, tt.body, err, tt.wantErr)
+		}
+		if farcaster.IsNotFound(err) != tt.notFound {
+			t.Errorf("%d %s: IsNotFound() = %v", tt.status, tt.body, !tt.notFound)
+		}
+		if farcaster.IsRateLimited(err) != tt.rateLimited {
+			t.Errorf("%d %s: IsRateLimited() = %v", tt.status, tt.body, !tt.rateLimited)
+		}
+		if farcaster.IsUnauthorized(err) != tt.unauthorized {
+			t.Errorf("%d %s: IsUnauthorized() = %v", tt.status, tt.body, !tt.unauthorized)
+		}
+	}
+
+	if farcaster.IsNotFound(errors.New("not found")) {
+		t.Error("IsNotFound() matched an error that is not an *APIError")
+	}
+}
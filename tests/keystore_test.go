@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+func TestKeystoreRoundTrip(t *testing.T) {
+	if testing.Short() {
+		t.Skip("scrypt with standard parameters is slow")
+	}
+
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "custody.json")
+	if err := account.WriteKeystore(path, "hunter2"); err != nil {
+		t.Fatalf("WriteKeystore() failed: %v", err)
+	}
+	if err := account.WriteKeystore(path, "hunter2"); err == nil {
+		t.Error("WriteKeystore() overwrote an existing file")
+	}
+
+	if _, err := farcaster.LocalAccountFromKeystore(path, "wrong"); err == nil {
+		t.Error("LocalAccountFromKeystore() accepted a wrong passphrase")
+	}
+
+	loaded, err := farcaster.LocalAccountFromKeystore(path, "hunter2")
+	if err != nil {
+		t.Fatalf("LocalAccountFromKeystore() failed: %v", err)
+	}
+	if *loaded != *account {
+		t.Errorf("LocalAccountFromKeystore() = %+v, want %+v", loaded, account)
+	}
+}
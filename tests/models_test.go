@@ -0,0 +1,251 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+const userJSON = `{
+	"fid": 3,
+	"username": "dwr.eth",
+	"displayName": "Dan Romero",
+	"pfp": {"url": "https://i.imgur.com/dwr.jpg", "verified": false},
+	"profile": {
+		"bio": {"text": "Working on Farcaster", "mentions": ["farcaster"], "channelMentions": []},
+		"location": {"placeId": "ChIJ", "description": "Los Angeles, CA, USA"}
+	},
+	"followerCount": 100,
+	"followingCount": 20,
+	"activeOnFcNetwork": true,
+	"verifications": ["0xd7029bdea1c17493893aafe29aad69ef892b8ff2"],
+	"viewerContext": {"following": true, "followedBy": false, "canSendDirectCasts": true}
+}`
+
+func TestUserModel(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"result":{"users":[` + userJSON + `]}}`))
+	}))
+
+	result, err := client.GetFollowers(1, nil, 1)
+	if err != nil {
+		t.Fatalf("GetFollowers() failed: %v", err)
+	}
+	if len(result.Users) != 1 {
+		t.Fatalf("GetFollowers() returned %d users, want 1", len(result.Users))
+	}
+
+	user := result.Users[0]
+	if user.Pfp == nil || user.Pfp.URL != "https://i.imgur.com/dwr.jpg" {
+		t.Errorf("Pfp = %+v", user.Pfp)
+	}
+	if user.Profile == nil || user.Profile.Bio.Mentions[0] != "farcaster" || user.Profile.Location.Description != "Los Angeles, CA, USA" {
+		t.Errorf("Profile = %+v", user.Profile)
+	}
+	if user.FollowerCount != 100 || user.FollowingCount != 20 || !user.ActiveOnFcNetwork {
+		t.Errorf("counts or active status not decoded: %+v", user)
+	}
+	if len(user.Verifications) != 1 {
+		t.Errorf("Verifications = %v", user.Verifications)
+	}
+	if user.ViewerContext == nil || !user.ViewerContext.Following || user.ViewerContext.CanSendDirectCasts == nil {
+		t.Errorf("ViewerContext = %+v", user.ViewerContext)
+	}
+}
+
+const castJSON = `{
+	"hash": "0xabc",
+	"threadHash": "0xabc",
+	"parentSource": {"type": "url", "url": "https://warpcast.com/~/channel/farcaster"},
+	"author": ` + userJSON + `,
+	"text": "gm @v",
+	"timestamp": 1700000000000,
+	"mentions": [{"fid": 2, "username": "v"}],
+	"embeds": {
+		"images": [{"type": "image", "url": "https://i.imgur.com/a.png", "sourceUrl": "https://i.imgur.com/a.png", "alt": "a"}],
+		"urls": [{"type": "url", "openGraph": {"url": "https://farcaster.xyz", "sourceUrl": "https://farcaster.xyz", "title": "Farcaster", "domain": "farcaster.xyz", "useLargeImage": true}}],
+		"casts": [{"hash": "0xdef", "threadHash": "0xdef", "author": {"fid": 2}, "text": "quoted", "timestamp": 1690000000000}],
+		"processedCastText": "gm @v"
+	},
+	"replies": {"count": 4},
+	"reactions": {"count": 10},
+	"recasts": {"count": 2, "recasters": [{"fid": 5, "username": "a", "recastHash": "0x123"}]},
+	"watches": {"count": 1},
+	"quoteCount": 1,
+	"combinedRecastCount": 3,
+	"channel": {"key": "farcaster", "name": "Farcaster"},
+	"viewerContext": {"reacted": true, "recast": false}
+}`
+
+func TestCastModel(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/cast", "/v2/casts":
+			w.Write([]byte(`{"result":{"cast":` + castJSON + `}}`))
+		case "/v2/all-casts-in-thread":
+			w.Write([]byte(`{"result":{"casts":[` + castJSON + `]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	cast, err := client.GetCast("0xabc")
+	if err != nil {
+		t.Fatalf("GetCast() failed: %v", err)
+	}
+	if cast.Author.FID != 3 || cast.Timestamp != 1700000000000 || len(cast.Mentions) != 1 {
+		t.Errorf("cast not decoded: %+v", cast)
+	}
+	if cast.Replies.Count != 4 || cast.Reactions.Count != 10 || cast.Recasts.Count != 2 || cast.Watches.Count != 1 {
+		t.Errorf("counts not decoded: %+v", cast)
+	}
+	if len(cast.Recasts.Recasters) != 1 || cast.Recasts.Recasters[0].RecastHash != "0x123" {
+		t.Errorf("Recasters = %+v", cast.Recasts.Recasters)
+	}
+	if cast.ViewerContext == nil || !cast.ViewerContext.Reacted || cast.ViewerContext.Recast {
+		t.Errorf("ViewerContext = %+v", cast.ViewerContext)
+	}
+	if cast.Channel == nil || cast.Channel.Key != "farcaster" || cast.ParentSource == nil || cast.ParentSource.Type != "url" {
+		t.Errorf("Channel = %+v, ParentSource = %+v", cast.Channel, cast.ParentSource)
+	}
+
+	embeds := cast.Embeds
+	if embeds == nil || len(embeds.Images) != 1 || len(embeds.Urls) != 1 || len(embeds.Casts) != 1 {
+		t.Fatalf("Embeds = %+v", embeds)
+	}
+	if og := embeds.Urls[0].OpenGraph; og.Title != "Farcaster" || !og.UseLargeImage {
+		t.Errorf("OpenGraph = %+v", og)
+	}
+	if embeds.Casts[0].Text != "quoted" {
+		t.Errorf("quoted cast = %+v", embeds.Casts[0])
+	}
+
+	posted, err := client.PostCast("gm @v", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("PostCast() failed: %v", err)
+	}
+	if posted.Hash != "0xabc" || posted.Reactions.Count != 10 {
+		t.Errorf("PostCast() = %+v", posted)
+	}
+
+	thread, err := client.GetAllCastsInThread("0xabc")
+	if err != nil {
+		t.Fatalf("GetAllCastsInThread() failed: %v", err)
+	}
+	if len(thread.Casts) != 1 || thread.Casts[0].Embeds == nil {
+		t.Errorf("GetAllCastsInThread() = %+v", thread)
+	}
+}
+
+func TestUnknownFieldsRoundTrip(t *testing.T) {
+	payload := `{"hash":"0xabc","author":{"fid":3,"newUserField":{"a":1}},"text":"hi","newCastField":[1,2,3],"embeds":{"images":[{"url":"u","newImageField":"x"}]}}`
+
+	var cast farcaster.ApiCast
+	if err := json.Unmarshal([]byte(payload), &cast); err != nil {
+		t.Fatalf("Unmarshal() failed: %v", err)
+	}
+	if string(cast.Extras["newCastField"]) != "[1,2,3]" {
+		t.Errorf("cast Extras = %v", cast.Extras)
+	}
+	if _, ok := cast.Extras["hash"]; ok {
+		t.Error("known field kept in Extras")
+	}
+	if string(cast.Author.Extras["newUserField"]) != `{"a":1}` {
+		t.Errorf("author Extras = %v", cast.Author.Extras)
+	}
+
+	data, err := json.Marshal(cast)
+	if err != nil {
+		t.Fatalf("Marshal() failed: %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("re-marshalled cast is not valid JSON: %v", err)
+	}
+	if got["newCastField"] == nil || got["author"].(map[string]interface{})["newUserField"] == nil {
+		t.Errorf("extras lost on re-marshal: %s", data)
+	}
+	image := got["embeds"].(map[string]interface{})["images"].([]interface{})[0].(map[string]interface{})
+	if image["newImageField"] != "x" {
+		t.Errorf("nested extras lost on re-marshal: %s", data)
+	}
+
+	var again farcaster.ApiCast
+	if err := json.Unmarshal(data, &again); err != nil {
+		t.Fatalf("Unmarshal() of re-marshalled cast failed: %v", err)
+	}
+	if again.Hash != cast.Hash || string(again.Extras["newCastField"]) != "[1,2,3]" {
+		t.Errorf("re-decoded cast = %+v", again)
+	}
+}
+
+func TestResponseModelsKeepExtras(t *testing.T) {
+	var token farcaster.TokenResult
+	var status farcaster.StatusContent
+	var like farcaster.ReactionsPutResult
+	var recast farcaster.RecastsPutResult
+	var unseen farcaster.UnseenCounts
+
+	tests := []struct {
+		name    string
+		payload string
+		model   interface{}
+		extras  func() farcaster.Extras
+	}{
+		{"TokenResult", `{"token":{"secret":"MK-1","expiresAt":1},"new":1}`, &token, func() farcaster.Extras { return token.Extras }},
+		{"AccessToken", `{"token":{"secret":"MK-1","expiresAt":1,"new":1}}`, &token, func() farcaster.Extras { return token.Token.Extras }},
+		{"StatusContent", `{"success":true,"new":1}`, &status, func() farcaster.Extras { return status.Extras }},
+		{"ReactionsPutResult", `{"like":{"castHash":"0xabc"},"new":1}`, &like, func() farcaster.Extras { return like.Extras }},
+		{"CastLike", `{"like":{"castHash":"0xabc","new":1}}`, &like, func() farcaster.Extras { return like.Like.Extras }},
+		{"RecastsPutResult", `{"castHash":"0xabc","new":1}`, &recast, func() farcaster.Extras { return recast.Extras }},
+		{"UnseenCounts", `{"notificationsCount":2,"inboxCount":0,"new":1}`, &unseen, func() farcaster.Extras { return unseen.Extras }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tt.payload), tt.model); err != nil {
+				t.Fatalf("Unmarshal() failed: %v", err)
+			}
+			if extras := tt.extras(); len(extras) != 1 || string(extras["new"]) != "1" {
+				t.Errorf("Extras = %v, want only new", extras)
+			}
+
+			data, err := json.Marshal(tt.model)
+			if err != nil {
+				t.Fatalf("Marshal() failed: %v", err)
+			}
+			var got map[string]interface{}
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("re-marshalled model is not valid JSON: %v", err)
+			}
+			if !strings.Contains(string(data), `"new":1`) {
+				t.Errorf("extras lost on re-marshal: %s", data)
+			}
+		})
+	}
+}
+
+func TestCaseFoldedFieldRoundTrip(t *testing.T) {
+	var user farcaster.ApiUser
+	if err := json.Unmarshal([]byte(`{"fid":1,"FID":2}`), &user); err != nil {
+		t.Fatalf("Unmarshal() failed: %v", err)
+	}
+	if user.FID != 1 {
+		t.Errorf("FID = %d, want 1 from the exact key", user.FID)
+	}
+	if string(user.Extras["FID"]) != "2" {
+		t.Errorf("Extras = %v, want FID kept", user.Extras)
+	}
+
+	data, err := json.Marshal(user)
+	if err != nil {
+		t.Fatalf("Marshal() failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"fid":1`) || !strings.Contains(string(data), `"FID":2`) {
+		t.Errorf("Marshal() = %s, want both fid and FID kept", data)
+	}
+}
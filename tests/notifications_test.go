@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+func TestMentionAndReplyNotifications(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/mention-and-reply-notifications":
+			if r.URL.Query().Get("cursor") == "" {
+				w.Write([]byte(`{"result":{"notifications":[
+					{"type":"cast-mention","id":"n1","timestamp":3,"actor":{"fid":2},"content":{"cast":{"hash":"0x1","text":"hey @me"}}},
+					{"type":"cast-reply","id":"n2","timestamp":2,"actor":{"fid":5},"content":{"cast":{"hash":"0x2","parentHash":"0x0"}}}
+				]},"next":{"cursor":"c1"}}`))
+				return
+			}
+			w.Write([]byte(`{"result":{"notifications":[{"type":"cast-quote","id":"n3","timestamp":1}]}}`))
+		case "/v2/unseen":
+			w.Write([]byte(`{"result":{"notificationsCount":7,"inboxCount":1}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	result, err := client.GetMentionAndReplyNotifications(nil, 2)
+	if err != nil {
+		t.Fatalf("GetMentionAndReplyNotifications() failed: %v", err)
+	}
+	if len(result.Notifications) != 2 || result.Cursor == nil || *result.Cursor != "c1" {
+		t.Fatalf("GetMentionAndReplyNotifications() = %+v", result)
+	}
+
+	mention, ok := result.Notifications[0].(*farcaster.MentionNotification)
+	if !ok || mention.Actor.FID != 2 || mention.Content.Cast.Text != "hey @me" {
+		t.Errorf("first notification = %#v, want mention", result.Notifications[0])
+	}
+	reply, ok := result.Notifications[1].(*farcaster.ReplyNotification)
+	if !ok || reply.Content.Cast.ParentHash == nil {
+		t.Errorf("second notification = %#v, want reply", result.Notifications[1])
+	}
+
+	var ids []string
+	for notification, err := range client.NotificationsIter(context.Background()).All() {
+		if err != nil {
+			t.Fatalf("NotificationsIter() yielded error: %v", err)
+		}
+		ids = append(ids, notification.NotificationID())
+	}
+	if len(ids) != 3 || ids[2] != "n3" {
+		t.Fatalf("NotificationsIter() yielded %v", ids)
+	}
+
+	count, err := client.GetUnseenNotificationsCount()
+	if err != nil {
+		t.Fatalf("GetUnseenNotificationsCount() failed: %v", err)
+	}
+	if count != 7 {
+		t.Errorf("GetUnseenNotificationsCount() = %d, want 7", count)
+	}
+}
+
+func TestUnknownNotificationMarshalJSON(t *testing.T) {
+	raw := `{"type":"cast-quote","id":"n3","timestamp":1}`
+	notifications := []farcaster.Notification{
+		&farcaster.UnknownNotification{Type: "cast-quote", ID: "n3", Raw: json.RawMessage(raw)},
+		&farcaster.UnknownNotification{Type: "cast-quote", ID: "n4"},
+	}
+
+	data, err := json.Marshal(notifications)
+	if err != nil {
+		t.Fatalf("Marshal() failed: %v", err)
+	}
+	if want := "[" + raw + ",null]"; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+}
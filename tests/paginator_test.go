@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// pagedFollowers serves three pages of two followers each
+func pagedFollowers(w http.ResponseWriter, r *http.Request) {
+	page := 0
+	fmt.Sscan(r.URL.Query().Get("cursor"), &page)
+
+	next := fmt.Sprintf(`,"next":{"cursor":"%d"}`, page+1)
+	if page == 2 {
+		next = ""
+	}
+	fmt.Fprintf(w, `{"result":{"users":[{"fid":%d},{"fid":%d}]}%s}`, page*2+1, page*2+2, next)
+}
+
+func TestFollowersIter(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(pagedFollowers))
+
+	var fids []int
+	for user, err := range client.FollowersIter(context.Background(), 1).All() {
+		if err != nil {
+			t.Fatalf("FollowersIter() failed: %v", err)
+		}
+		fids = append(fids, user.FID)
+	}
+
+	if len(fids) != 6 || fids[0] != 1 || fids[5] != 6 {
+		t.Errorf("FollowersIter() yielded fids %v, want 1..6", fids)
+	}
+}
+
+func TestFollowersIterResume(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(pagedFollowers))
+	pages := client.FollowersIter(context.Background(), 1)
+
+	// Stop in the middle of the second page
+	for user, err := range pages.All() {
+		if err != nil {
+			t.Fatalf("FollowersIter() failed: %v", err)
+		}
+		if user.FID == 3 {
+			break
+		}
+	}
+
+	cursor := pages.Cursor()
+	if cursor == nil || *cursor != "1" {
+		t.Fatalf("Cursor() = %v, want the second page", cursor)
+	}
+
+	resumed := client.FollowersIter(context.Background(), 1)
+	resumed.SetCursor(cursor)
+
+	var fids []int
+	for user, err := range resumed.All() {
+		if err != nil {
+			t.Fatalf("FollowersIter() failed: %v", err)
+		}
+		fids = append(fids, user.FID)
+	}
+
+	if len(fids) != 4 || fids[0] != 3 {
+		t.Errorf("resumed iterator yielded fids %v, want 3..6", fids)
+	}
+	if !resumed.Done() || resumed.Cursor() != nil {
+		t.Errorf("resumed iterator not done after last page")
+	}
+}
+
+func TestFollowersIterBreakThenResume(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(pagedFollowers))
+	pages := client.FollowersIter(context.Background(), 1)
+
+	var fids []int
+	for user, err := range pages.All() {
+		if err != nil {
+			t.Fatalf("FollowersIter() failed: %v", err)
+		}
+		fids = append(fids, user.FID)
+		if user.FID == 3 {
+			break
+		}
+	}
+
+	cursor, offset := pages.Cursor(), pages.Offset()
+	if cursor == nil || *cursor != "1" || offset != 1 {
+		t.Fatalf("Cursor(), Offset() = %v, %d, want the second page after one item", cursor, offset)
+	}
+
+	// Ranging again continues after the last yielded item
+	for user, err := range pages.All() {
+		if err != nil {
+			t.Fatalf("FollowersIter() failed: %v", err)
+		}
+		fids = append(fids, user.FID)
+	}
+	if fmt.Sprint(fids) != "[1 2 3 4 5 6]" {
+		t.Errorf("break then resume yielded fids %v, want 1..6 once each", fids)
+	}
+
+	// A saved cursor and offset resume at the same place in a new paginator
+	resumed := client.FollowersIter(context.Background(), 1)
+	resumed.SetCursor(cursor)
+	resumed.SetOffset(offset)
+
+	fids = nil
+	for user, err := range resumed.All() {
+		if err != nil {
+			t.Fatalf("FollowersIter() failed: %v", err)
+		}
+		fids = append(fids, user.FID)
+	}
+	if fmt.Sprint(fids) != "[4 5 6]" {
+		t.Errorf("resumed iterator yielded fids %v, want 4..6", fids)
+	}
+}
+
+func TestGetFollowersLimit(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(pagedFollowers))
+
+	result, err := client.GetFollowers(1, nil, 4)
+	if err != nil {
+		t.Fatalf("GetFollowers() failed: %v", err)
+	}
+	if len(result.Users) != 4 {
+		t.Errorf("GetFollowers() returned %d users, want 4", len(result.Users))
+	}
+	if result.Cursor == nil || *result.Cursor != "2" {
+		t.Errorf("GetFollowers() cursor = %v, want the third page", result.Cursor)
+	}
+}
+
+func TestGetFollowersLargerPageThanRequested(t *testing.T) {
+	// pagedFollowers ignores limit and always sends two followers
+	client := newTestClient(t, http.HandlerFunc(pagedFollowers))
+
+	first, err := client.GetFollowers(1, nil, 3)
+	if err != nil {
+		t.Fatalf("GetFollowers() failed: %v", err)
+	}
+	if len(first.Users) != 4 || first.Cursor == nil || *first.Cursor != "2" {
+		t.Fatalf("GetFollowers() = %d users, cursor %v; want the second page finished and the third next", len(first.Users), first.Cursor)
+	}
+
+	second, err := client.GetFollowers(1, first.Cursor, 3)
+	if err != nil {
+		t.Fatalf("GetFollowers() from cursor failed: %v", err)
+	}
+	if len(second.Users) != 2 || second.Users[0].FID != 5 || second.Cursor != nil {
+		t.Errorf("GetFollowers() from cursor = %+v, want fids 5 and 6 and no cursor", second)
+	}
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+func TestRecasts(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /v2/recasts", "DELETE /v2/recasts":
+			var body farcaster.RecastsPutRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("%s body: %v", r.Method, err)
+			}
+			if body.CastHash != "0xabc" {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"errors":[{"message":"cast not found"}]}`))
+				return
+			}
+			if r.Method == "PUT" {
+				w.Write([]byte(`{"result":{"castHash":"0xabc"}}`))
+				return
+			}
+			w.Write([]byte(`{"result":{"success":true}}`))
+		case "GET /v2/cast-recasters":
+			if r.URL.Query().Get("castHash") != "0xabc" {
+				t.Errorf("cast-recasters castHash = %q", r.URL.Query().Get("castHash"))
+			}
+			if r.URL.Query().Get("cursor") == "" {
+				w.Write([]byte(`{"result":{"users":[{"fid":2}]},"next":{"cursor":"c1"}}`))
+				return
+			}
+			w.Write([]byte(`{"result":{"users":[{"fid":3}]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	recast, err := client.Recast("0xabc")
+	if err != nil {
+		t.Fatalf("Recast() failed: %v", err)
+	}
+	if recast.CastHash != "0xabc" {
+		t.Errorf("Recast() castHash = %q, want 0xabc", recast.CastHash)
+	}
+
+	status, err := client.DeleteRecast("0xabc")
+	if err != nil {
+		t.Fatalf("DeleteRecast() failed: %v", err)
+	}
+	if !status.Success {
+		t.Error("DeleteRecast() success = false")
+	}
+
+	recasters, err := client.GetCastRecasters("0xabc", nil, 5)
+	if err != nil {
+		t.Fatalf("GetCastRecasters() failed: %v", err)
+	}
+	if len(recasters.Users) != 2 || recasters.Users[1].FID != 3 || recasters.Cursor != nil {
+		t.Errorf("GetCastRecasters() = %+v", recasters)
+	}
+
+	if _, err := client.Recast("0xmissing"); !farcaster.IsNotFound(err) {
+		t.Errorf("Recast() of unknown cast error = %v, want not found", err)
+	}
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+// revokeServer mints tokens that expire at expiresAt and counts mints and
+// revocations
+func revokeServer(t *testing.T, expiresAt int64) (*httptest.Server, *atomic.Int32, *atomic.Int32) {
+	var mints, revokes atomic.Int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			n := mints.Add(1)
+			fmt.Fprintf(w, `{"result":{"token":{"secret":"MK-%d","expiresAt":%d}}}`, n, expiresAt)
+		case "DELETE":
+			revokes.Add(1)
+			w.Write([]byte(`{"result":{"success":true}}`))
+		}
+	}))
+	t.Cleanup(api.Close)
+	return api, &mints, &revokes
+}
+
+func TestCloseRevokesToken(t *testing.T) {
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+
+	api, mints, revokes := revokeServer(t, 33228645430000)
+	client, err := farcaster.NewWarpcast(
+		farcaster.WithWallet(account),
+		farcaster.WithBasePath(api.URL+"/v2/"),
+		farcaster.WithBackgroundRefresh(time.Minute),
+		farcaster.WithRevokeOnClose(),
+	)
+	if err != nil {
+		t.Fatalf("NewWarpcast() failed: %v", err)
+	}
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Errorf("second Close() failed: %v", err)
+	}
+	if got := revokes.Load(); got != 1 {
+		t.Errorf("revoked %d times, want 1", got)
+	}
+	if got := mints.Load(); got != 1 {
+		t.Errorf("minted %d tokens, want 1", got)
+	}
+}
+
+func TestCloseSkipsRevokingExpiredToken(t *testing.T) {
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+
+	api, mints, revokes := revokeServer(t, time.Now().Add(-time.Minute).UnixMilli())
+	client, err := farcaster.NewWarpcast(
+		farcaster.WithWallet(account),
+		farcaster.WithBasePath(api.URL+"/v2/"),
+		farcaster.WithRevokeOnClose(),
+	)
+	if err != nil {
+		t.Fatalf("NewWarpcast() failed: %v", err)
+	}
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+	if got := mints.Load(); got != 1 {
+		t.Errorf("minted %d tokens, want Close not to mint one just to revoke it", got)
+	}
+	if got := revokes.Load(); got != 0 {
+		t.Errorf("revoked %d times, want 0", got)
+	}
+}
@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+func TestRetryOnRateLimit(t *testing.T) {
+	var attempts atomic.Int32
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"errors":[{"message":"slow down"}]}`))
+			return
+		}
+		w.Write([]byte(`{"result":{"cast":{"hash":"0x1"}}}`))
+	}), farcaster.WithRetryPolicy(farcaster.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}))
+
+	cast, err := client.GetCast("0x1")
+	if err != nil {
+		t.Fatalf("GetCast() failed: %v", err)
+	}
+	if cast.Hash != "0x1" {
+		t.Errorf("GetCast() hash = %q, want 0x1", cast.Hash)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("server saw %d attempts, want 2", got)
+	}
+}
+
+func TestNoRetryForPost(t *testing.T) {
+	var attempts atomic.Int32
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}), farcaster.WithRetryPolicy(farcaster.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}))
+
+	if _, err := client.PostCast("hello", nil, nil, nil); err == nil {
+		t.Fatal("PostCast() succeeded, want error")
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("server saw %d attempts, want 1", got)
+	}
+}
+
+func TestRetryAfterBeyondMaxBackoff(t *testing.T) {
+	var attempts atomic.Int32
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "86400")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}), farcaster.WithRetryPolicy(farcaster.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Second,
+	}))
+
+	start := time.Now()
+	_, err := client.GetCast("0x1")
+	if !farcaster.IsRateLimited(err) {
+		t.Fatalf("GetCast() error = %v, want rate limited", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("GetCast() took %v, want it to give up instead of waiting", elapsed)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("server saw %d attempts, want 1", got)
+	}
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+// authServer answers PUT /v2/auth after checking that the custody header was
+// signed by address
+func authServer(t *testing.T, address string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/v2/auth" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body farcaster.AuthPutRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode auth request: %v", err)
+		}
+		if body.Method != "generateToken" {
+			t.Errorf("auth request method = %q, want generateToken", body.Method)
+		}
+
+		signer, err := farcaster.RecoverCustodyAddress(r.Header.Get("Authorization"), body.Params)
+		if err != nil || signer != address {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"errors":[{"message":"bad signature"}]}`))
+			return
+		}
+
+		w.Write([]byte(`{"result":{"token":{"secret":"MK-minted","expiresAt":33228645430000}}}`))
+	})
+}
+
+func TestRemoteSigner(t *testing.T) {
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+
+	signingService := httptest.NewServer(farcaster.NewSignerHandler(account))
+	defer signingService.Close()
+
+	api := httptest.NewServer(authServer(t, account.Address))
+	defer api.Close()
+
+	signer := &farcaster.RemoteSigner{URL: signingService.URL, Address: account.Address}
+	client, err := farcaster.NewWarpcast(
+		farcaster.WithSigner(signer),
+		farcaster.WithBasePath(api.URL+"/v2/"),
+	)
+	if err != nil {
+		t.Fatalf("NewWarpcast() with remote signer failed: %v", err)
+	}
+	if client == nil {
+		t.Fatal("NewWarpcast() returned nil client")
+	}
+}
+
+func TestRemoteSignerUnknownAddress(t *testing.T) {
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+
+	signingService := httptest.NewServer(farcaster.NewSignerHandler(account))
+	defer signingService.Close()
+
+	signer := &farcaster.RemoteSigner{URL: signingService.URL, Address: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"}
+	if _, err := signer.SignPersonalMessage(context.Background(), []byte("hello")); err == nil {
+		t.Error("SignPersonalMessage() succeeded for an address the service does not hold")
+	}
+}
+
+func TestRemoteSignerHTTPError(t *testing.T) {
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html><body>502 Bad Gateway</body></html>"))
+	}))
+	defer gateway.Close()
+
+	signer := &farcaster.RemoteSigner{URL: gateway.URL, Address: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"}
+	_, err := signer.SignPersonalMessage(context.Background(), []byte("hello"))
+	if err == nil {
+		t.Fatal("SignPersonalMessage() succeeded against a failing gateway")
+	}
+	if msg := err.Error(); !strings.Contains(msg, "502") || !strings.Contains(msg, "Bad Gateway") {
+		t.Errorf("SignPersonalMessage() error = %q, want the status and body", msg)
+	}
+}
@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+func TestStreamDeduplicatesAndPauses(t *testing.T) {
+	polls := [][]string{
+		{"c", "b", "a"},
+		{"d", "c", "b"},
+		{"d", "c", "b"},
+	}
+	calls := 0
+	fetch := func(ctx context.Context, limit int) ([]string, error) {
+		items := polls[min(calls, len(polls)-1)]
+		calls++
+		return items, nil
+	}
+
+	pauseAfter := 0
+	stream := farcaster.NewStream(fetch, func(s string) string { return s }, farcaster.StreamOptions{
+		PauseAfter: &pauseAfter,
+	})
+
+	var got []string
+	for item, err := range stream.All(context.Background()) {
+		if err != nil {
+			t.Fatalf("stream yielded error: %v", err)
+		}
+		got = append(got, item)
+	}
+
+	want := []string{"a", "b", "c", "d"}
+	if len(got) != len(want) {
+		t.Fatalf("stream yielded %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("stream yielded %v, want %v", got, want)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("stream polled %d times, want 3", calls)
+	}
+}
+
+func TestStreamYieldsErrors(t *testing.T) {
+	errPoll := errors.New("poll failed")
+	fetch := func(ctx context.Context, limit int) ([]string, error) {
+		return nil, errPoll
+	}
+
+	stream := farcaster.NewStream(fetch, func(s string) string { return s }, farcaster.StreamOptions{})
+	for _, err := range stream.All(context.Background()) {
+		if !errors.Is(err, errPoll) {
+			t.Fatalf("stream yielded %v, want %v", err, errPoll)
+		}
+		break
+	}
+}
+
+func TestStreamStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fetch := func(ctx context.Context, limit int) ([]string, error) {
+		cancel()
+		return nil, nil
+	}
+
+	stream := farcaster.NewStream(fetch, func(s string) string { return s }, farcaster.StreamOptions{})
+	for _, err := range stream.All(ctx) {
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("stream yielded %v, want context.Canceled", err)
+		}
+	}
+}
+
+// pollServer answers path with one body per request, repeating the last body
+// once they run out
+func pollServer(t *testing.T, path string, bodies ...string) *farcaster.Warpcast {
+	var polls atomic.Int32
+	return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			http.NotFound(w, r)
+			return
+		}
+		i := min(int(polls.Add(1))-1, len(bodies)-1)
+		w.Write([]byte(bodies[i]))
+	}))
+}
+
+// pollOnce ranges over a single poll of stream and returns the keys of the
+// yielded items
+func pollOnce[T any](t *testing.T, stream *farcaster.Stream[T], key func(T) string) []string {
+	t.Helper()
+
+	var keys []string
+	for item, err := range stream.All(context.Background()) {
+		if err != nil {
+			t.Fatalf("stream yielded error: %v", err)
+		}
+		keys = append(keys, key(item))
+	}
+	return keys
+}
+
+// testStreamWrapper checks that a stream built by newStream dedupes on key
+// across polls and across a restart that reuses the persisted seen-set
+//
+// item renders an item with the given key and a text that changes between
+// polls, so that only the key can be what dedupes it
+func testStreamWrapper[T any](t *testing.T, path, list string, item func(key int, text string) string, newStream func(*farcaster.Warpcast, farcaster.StreamOptions) *farcaster.Stream[T], key func(T) string) {
+	page := func(text string, keys ...int) string {
+		items := make([]string, len(keys))
+		for i, k := range keys {
+			items[i] = item(k, text)
+		}
+		return fmt.Sprintf(`{"result":{%q:[%s]}}`, list, strings.Join(items, ","))
+	}
+
+	pauseAfter := -1
+	opts := farcaster.StreamOptions{
+		PauseAfter: &pauseAfter,
+		SeenPath:   filepath.Join(t.TempDir(), "seen.json"),
+	}
+
+	client := pollServer(t, path,
+		page("first", 2, 1),
+		page("edited", 3, 2, 1),
+	)
+	stream := newStream(client, opts)
+
+	if got := pollOnce(t, stream, key); fmt.Sprint(got) != "[1 2]" {
+		t.Errorf("first poll yielded %v, want [1 2]", got)
+	}
+	if got := pollOnce(t, stream, key); fmt.Sprint(got) != "[3]" {
+		t.Errorf("second poll yielded %v, want [3]", got)
+	}
+
+	// A restarted stream picks up the seen-set from SeenPath
+	restarted := newStream(pollServer(t, path, page("restarted", 4, 3, 2, 1)), opts)
+	if got := pollOnce(t, restarted, key); fmt.Sprint(got) != "[4]" {
+		t.Errorf("poll after restart yielded %v, want [4]", got)
+	}
+}
+
+func TestStreamCasts(t *testing.T) {
+	testStreamWrapper(t, "/v2/recent-casts", "casts", func(key int, text string) string {
+		return fmt.Sprintf(`{"hash":"%d","author":{"fid":%d},"text":%q}`, key, 100+key, text)
+	}, (*farcaster.Warpcast).StreamCasts, func(cast farcaster.ApiCast) string {
+		return cast.Hash
+	})
+}
+
+func TestStreamRecentUsers(t *testing.T) {
+	testStreamWrapper(t, "/v2/recent-users", "users", func(key int, text string) string {
+		return fmt.Sprintf(`{"fid":%d,"username":"%s-%d"}`, key, text, key)
+	}, (*farcaster.Warpcast).StreamRecentUsers, func(user farcaster.ApiUser) string {
+		return strconv.Itoa(user.FID)
+	})
+}
+
+func TestStreamNotifications(t *testing.T) {
+	testStreamWrapper(t, "/v2/mention-and-reply-notifications", "notifications", func(key int, text string) string {
+		return fmt.Sprintf(`{"type":"cast-mention","id":"%d","actor":{"fid":2},"content":{"cast":{"hash":"0x%s%d"}}}`, key, text, key)
+	}, (*farcaster.Warpcast).StreamNotifications, farcaster.Notification.NotificationID)
+}
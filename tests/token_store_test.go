@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+func TestTokenStoreReusesToken(t *testing.T) {
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+
+	var mints atomic.Int32
+	auth := authServer(t, account.Address)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mints.Add(1)
+		auth.ServeHTTP(w, r)
+	}))
+	defer api.Close()
+
+	store := farcaster.NewFileTokenStore(filepath.Join(t.TempDir(), "token.json"))
+	for i := 0; i < 2; i++ {
+		if _, err := farcaster.NewWarpcast(
+			farcaster.WithWallet(account),
+			farcaster.WithBasePath(api.URL+"/v2/"),
+			farcaster.WithTokenStore(store),
+		); err != nil {
+			t.Fatalf("NewWarpcast() failed: %v", err)
+		}
+	}
+
+	if got := mints.Load(); got != 1 {
+		t.Errorf("minted %d tokens, want 1", got)
+	}
+
+	token, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if token == nil || token.Secret != "MK-minted" {
+		t.Errorf("Load() = %+v, want the minted token", token)
+	}
+}
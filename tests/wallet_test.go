@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	farcaster "github.com/aleskrin/go-farcaster-sdk"
+)
+
+// testMnemonic is the well-known development mnemonic used by Hardhat and Anvil
+const testMnemonic = "test test test test test test test test test test test junk"
+
+func TestLocalAccountFromMnemonic(t *testing.T) {
+	tests := []struct {
+		index   uint32
+		address string
+	}{
+		{0, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		{1, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"},
+	}
+
+	for _, tt := range tests {
+		account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, tt.index)
+		if err != nil {
+			t.Fatalf("LocalAccountFromMnemonic(%d) failed: %v", tt.index, err)
+		}
+		if account.Address != tt.address {
+			t.Errorf("LocalAccountFromMnemonic(%d) address = %s, want %s", tt.index, account.Address, tt.address)
+		}
+		if account.PublicKey == "" || account.PrivateKey == "" {
+			t.Errorf("LocalAccountFromMnemonic(%d) left keys empty", tt.index)
+		}
+	}
+}
+
+func TestLocalAccountFromInvalidMnemonic(t *testing.T) {
+	if _, err := farcaster.LocalAccountFromMnemonic("test test test test test test test test test test test test", 0); err == nil {
+		t.Error("LocalAccountFromMnemonic() accepted a mnemonic with a bad checksum")
+	}
+}
+
+func TestNewWarpcastValidatesWallet(t *testing.T) {
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		wallet *farcaster.LocalAccount
+	}{
+		{"unparsable key", &farcaster.LocalAccount{PrivateKey: "0xnothex"}},
+		{"mismatched address", &farcaster.LocalAccount{
+			PrivateKey: account.PrivateKey,
+			Address:    "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		}},
+		{"mismatched public key", &farcaster.LocalAccount{
+			PrivateKey: account.PrivateKey,
+			PublicKey:  "0x04deadbeef",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := farcaster.NewWarpcast(farcaster.WithWallet(tt.wallet)); err == nil {
+				t.Error("NewWarpcast() accepted an invalid wallet")
+			}
+		})
+	}
+}
+
+func TestLocalAccountFromPrivateKey(t *testing.T) {
+	account, err := farcaster.LocalAccountFromPrivateKey("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	if err != nil {
+		t.Fatalf("LocalAccountFromPrivateKey() failed: %v", err)
+	}
+	if account.Address != "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266" {
+		t.Errorf("LocalAccountFromPrivateKey() address = %s", account.Address)
+	}
+}
+
+func TestNewWarpcastSignsWithValidatedWallet(t *testing.T) {
+	account, err := farcaster.LocalAccountFromMnemonic(testMnemonic, 0)
+	if err != nil {
+		t.Fatalf("LocalAccountFromMnemonic() failed: %v", err)
+	}
+
+	var mints atomic.Int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/auth" {
+			w.Write([]byte(`{"result":{"cast":{"hash":"0x1"}}}`))
+			return
+		}
+
+		var body farcaster.AuthPutRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if signer, err := farcaster.RecoverCustodyAddress(r.Header.Get("Authorization"), body.Params); err != nil || signer != account.Address {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		// The first token is already expired so that the next request rotates
+		expiresAt := time.Now().UnixMilli()
+		if mints.Add(1) > 1 {
+			expiresAt = 33228645430000
+		}
+		fmt.Fprintf(w, `{"result":{"token":{"secret":"MK-%d","expiresAt":%d}}}`, mints.Load(), expiresAt)
+	}))
+	defer api.Close()
+
+	wallet := &farcaster.LocalAccount{PrivateKey: account.PrivateKey}
+	client, err := farcaster.NewWarpcast(farcaster.WithWallet(wallet), farcaster.WithBasePath(api.URL+"/v2/"))
+	if err != nil {
+		t.Fatalf("NewWarpcast() failed: %v", err)
+	}
+
+	// Later changes to the caller's struct must not affect signing
+	wallet.PrivateKey = ""
+
+	if _, err := client.GetCast("0x1"); err != nil {
+		t.Fatalf("GetCast() after rotation failed: %v", err)
+	}
+	if got := mints.Load(); got != 2 {
+		t.Errorf("server minted %d tokens, want 2", got)
+	}
+}
+
+func TestNewWarpcastRejectsShortRotationDuration(t *testing.T) {
+	var mints atomic.Int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mints.Add(1)
+		w.Write([]byte(`{"result":{"token":{"secret":"MK-1","expiresAt":33228645430000}}}`))
+	}))
+	defer api.Close()
+
+	// A bare integer is nanoseconds, not minutes
+	if _, err := farcaster.NewWarpcastFromMnemonic(testMnemonic, 200, farcaster.WithBasePath(api.URL+"/v2/")); err == nil {
+		t.Error("NewWarpcastFromMnemonic() accepted a rotation duration of 200ns")
+	}
+	if got := mints.Load(); got != 0 {
+		t.Errorf("minted %d tokens, want 0", got)
+	}
+
+	if _, err := farcaster.NewWarpcastFromMnemonic(testMnemonic, 200*time.Minute, farcaster.WithBasePath(api.URL+"/v2/")); err != nil {
+		t.Errorf("NewWarpcastFromMnemonic() with 200m failed: %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package farcaster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// StoredToken is an access token persisted by a TokenStore
+type StoredToken struct {
+	Secret    string `json:"secret"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// valid reports whether the token can still be used for at least margin
+// milliseconds after now, in Unix milliseconds
+func (t *StoredToken) valid(now, margin int64) bool {
+	return t != nil && t.Secret != "" && t.ExpiresAt > now+margin
+}
+
+// TokenStore persists the access token of a custody account across restarts
+//
+// A store holds a single token, so each custody account needs its own store
+type TokenStore interface {
+	// Load returns the stored token, or nil if there is none
+	Load(ctx context.Context) (*StoredToken, error)
+	// Save replaces the stored token
+	Save(ctx context.Context, token *StoredToken) error
+	// Clear removes the stored token
+	Clear(ctx context.Context) error
+}
+
+// WithTokenStore sets the store used to reuse and persist minted access tokens
+func WithTokenStore(store TokenStore) WarpcastOption {
+	return func(w *Warpcast) {
+		w.tokenStore = store
+	}
+}
+
+// MemoryTokenStore is a TokenStore that keeps the token in memory, which lets
+// several clients in one process share a token
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *StoredToken
+}
+
+// NewMemoryTokenStore creates an empty in-memory token store
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{}
+}
+
+// Load returns the stored token, or nil if there is none
+func (s *MemoryTokenStore) Load(ctx context.Context) (*StoredToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return nil, nil
+	}
+	token := *s.token
+	return &token, nil
+}
+
+// Save replaces the stored token
+func (s *MemoryTokenStore) Save(ctx context.Context, token *StoredToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := *token
+	s.token = &stored
+	return nil
+}
+
+// Clear removes the stored token
+func (s *MemoryTokenStore) Clear(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = nil
+	return nil
+}
+
+// FileTokenStore is a TokenStore that keeps the token in a JSON file readable
+// only by the current user
+type FileTokenStore struct {
+	// Path is the location of the token file
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileTokenStore creates a token store backed by the file at path
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{Path: path}
+}
+
+// Load returns the stored token, or nil if the file does not exist
+func (s *FileTokenStore) Load(ctx context.Context) (*StoredToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	var token StoredToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token file: %w", err)
+	}
+	return &token, nil
+}
+
+// Save replaces the token file atomically
+func (s *FileTokenStore) Save(ctx context.Context, token *StoredToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to marshal token: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create token file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.Path); err != nil {
+		return fmt.Errorf("failed to replace token file: %w", err)
+	}
+	return nil
+}
+
+// Clear removes the token file
+func (s *FileTokenStore) Clear(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.Path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+	return nil
+}
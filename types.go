@@ -1,18 +1,51 @@
 package farcaster
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
 
 // Warpcast represents a client for interacting with the Farcaster API
+//
+// A Warpcast client is safe for concurrent use by multiple goroutines
 type Warpcast struct {
+	// mu guards the token state, baseHeaders and config.Username
+	mu sync.RWMutex
+	// rotateMu ensures only one goroutine rotates the token at a time
+	rotateMu sync.Mutex
+
 	config           *ConfigurationParams
 	wallet           *LocalAccount
+	signer           CustodySigner
 	accessToken      *string
 	expiresAt        *int64
-	rotationDuration int64
+	tokenSetAt       int64
+	rotationDuration time.Duration
 	client           *http.Client
 	baseHeaders      map[string]string
-	BaseURL          string
-	HTTPClient       *http.Client
+	retryPolicy      RetryPolicy
+	tokenStore       TokenStore
+
+	// BaseURL overrides the base path of the API when set
+	//
+	// Deprecated: Use WithBasePath. BaseURL must not be changed once the
+	// client is in use
+	BaseURL string
+	// HTTPClient overrides the HTTP client used for every request when set
+	//
+	// Deprecated: Use WithHTTPClient. HTTPClient must not be changed once the
+	// client is in use
+	HTTPClient *http.Client
+
+	clock         clock
+	refreshMargin time.Duration
+	revokeOnClose bool
+	stopRefresher context.CancelFunc
+	refresherDone chan struct{}
+	closeOnce     sync.Once
+	closeErr      error
 }
 
 // LocalAccount represents a local wallet account
@@ -32,29 +65,84 @@ type ConfigurationParams struct {
 // AuthParams represents the parameters needed for authentication
 type AuthParams struct {
 	Timestamp int64 `json:"timestamp"`
-	// Add other required fields based on the API documentation
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+// AuthPutRequest represents the request body for generating an access token
+type AuthPutRequest struct {
+	Method string      `json:"method"`
+	Params *AuthParams `json:"params"`
 }
 
 // AssetResult represents the result of an asset query
 type AssetResult struct {
-	// Add fields as needed
+	Asset Asset `json:"asset"`
+}
+
+// Asset represents a single NFT
+type Asset struct {
+	ID         string          `json:"id"`
+	TokenID    string          `json:"tokenId"`
+	Name       string          `json:"name"`
+	ImageURL   string          `json:"imageUrl,omitempty"`
+	OpenSeaURL string          `json:"openSeaUrl,omitempty"`
+	Owner      *ApiUser        `json:"owner,omitempty"`
+	Collection AssetCollection `json:"collection"`
+
+	Extras Extras `json:"-"`
+}
+
+// AssetCollection represents an NFT collection
+type AssetCollection struct {
+	ID                  string  `json:"id"`
+	Name                string  `json:"name"`
+	Description         string  `json:"description,omitempty"`
+	ItemCount           int     `json:"itemCount"`
+	OwnerCount          int     `json:"ownerCount"`
+	FarcasterOwnerCount int     `json:"farcasterOwnerCount"`
+	ImageURL            string  `json:"imageUrl,omitempty"`
+	FloorPrice          *string `json:"floorPrice,omitempty"`
+	VolumeTraded        string  `json:"volumeTraded,omitempty"`
+	ExternalURL         string  `json:"externalUrl,omitempty"`
+	OpenSeaURL          string  `json:"openSeaUrl,omitempty"`
+	TwitterUsername     *string `json:"twitterUsername,omitempty"`
+	SchemaName          string  `json:"schemaName,omitempty"`
+
+	Extras Extras `json:"-"`
 }
 
 // TokenResult represents the result of a token operation
 type TokenResult struct {
-	Token struct {
-		Secret string `json:"secret"`
-	} `json:"token"`
+	Token AccessToken `json:"token"`
+
+	Extras Extras `json:"-"`
+}
+
+// AccessToken represents an access token and its expiry in Unix milliseconds
+type AccessToken struct {
+	Secret    string `json:"secret"`
+	ExpiresAt int64  `json:"expiresAt"`
+
+	Extras Extras `json:"-"`
 }
 
 // StatusContent represents a status response
 type StatusContent struct {
 	Success bool `json:"success"`
+
+	Extras Extras `json:"-"`
 }
 
-// Event represents a single asset event
+// Event represents a single asset event, such as a transfer of an NFT
 type Event struct {
-	// Add relevant fields based on your API response
+	ID              string   `json:"id"`
+	Timestamp       int64    `json:"timestamp"`
+	EventType       int      `json:"eventType"`
+	Asset           Asset    `json:"asset"`
+	TransactionHash string   `json:"transactionHash,omitempty"`
+	User            *ApiUser `json:"user,omitempty"`
+
+	Extras Extras `json:"-"`
 }
 
 // IterableEventsResult represents a paginated list of events
@@ -65,31 +153,224 @@ type IterableEventsResult struct {
 
 // ReactionsPutResult represents the result of liking a cast
 type ReactionsPutResult struct {
-	Like struct {
-		CastHash   string `json:"castHash"`
-		ReactorFid int    `json:"reactorFid"`
-		Timestamp  int64  `json:"timestamp"`
-	} `json:"like"`
+	Like CastLike `json:"like"`
+
+	Extras Extras `json:"-"`
 }
 
-// CastContent represents the content of a cast
-type CastContent struct {
-	Hash      string    `json:"hash"`
-	ThreadHash string   `json:"threadHash"`
-	ParentHash *string  `json:"parentHash,omitempty"`
-	Author    Author    `json:"author"`
-	Text      string    `json:"text"`
-	Embeds    []string  `json:"embeds,omitempty"`
-	// Add other fields as needed based on the API response
+// CastLike represents a like created by LikeCast
+type CastLike struct {
+	CastHash   string `json:"castHash"`
+	ReactorFid int    `json:"reactorFid"`
+	Timestamp  int64  `json:"timestamp"`
+
+	Extras Extras `json:"-"`
+}
+
+// CastLikesDeleteRequest represents the request to remove a like from a cast
+type CastLikesDeleteRequest struct {
+	CastHash string `json:"castHash"`
+}
+
+// CastReaction represents a like of a cast
+type CastReaction struct {
+	Type      string   `json:"type"`
+	Hash      string   `json:"hash"`
+	Reactor   ApiUser  `json:"reactor"`
+	Timestamp int64    `json:"timestamp"`
+	CastHash  string   `json:"castHash"`
+	Cast      *ApiCast `json:"cast,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// RecastsPutRequest represents the request to recast a cast
+type RecastsPutRequest struct {
+	CastHash string `json:"castHash"`
+}
+
+// RecastsPutResult represents the result of recasting a cast
+type RecastsPutResult struct {
+	CastHash string `json:"castHash"`
+
+	Extras Extras `json:"-"`
+}
+
+// RecastsDeleteRequest represents the request to undo a recast
+type RecastsDeleteRequest struct {
+	CastHash string `json:"castHash"`
+}
+
+// ApiCast represents a cast as returned by the Warpcast API
+type ApiCast struct {
+	Hash                string             `json:"hash"`
+	ThreadHash          string             `json:"threadHash"`
+	ParentHash          *string            `json:"parentHash,omitempty"`
+	ParentAuthor        *ApiUser           `json:"parentAuthor,omitempty"`
+	ParentSource        *ParentSource      `json:"parentSource,omitempty"`
+	Author              ApiUser            `json:"author"`
+	Text                string             `json:"text"`
+	Timestamp           int64              `json:"timestamp"`
+	Mentions            []ApiUser          `json:"mentions,omitempty"`
+	Embeds              *Embeds            `json:"embeds,omitempty"`
+	Replies             Replies            `json:"replies"`
+	Reactions           Reactions          `json:"reactions"`
+	Recasts             Recasts            `json:"recasts"`
+	Watches             Watches            `json:"watches"`
+	QuoteCount          int                `json:"quoteCount"`
+	CombinedRecastCount int                `json:"combinedRecastCount"`
+	Recast              *bool              `json:"recast,omitempty"`
+	Channel             *Channel           `json:"channel,omitempty"`
+	Tags                []Tag              `json:"tags,omitempty"`
+	ViewerContext       *CastViewerContext `json:"viewerContext,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// ParentSource represents a non-cast parent of a cast, such as a channel URL
+type ParentSource struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+
+	Extras Extras `json:"-"`
+}
+
+// Replies holds the reply count of a cast
+type Replies struct {
+	Count int `json:"count"`
+
+	Extras Extras `json:"-"`
+}
+
+// Reactions holds the like count of a cast
+type Reactions struct {
+	Count int `json:"count"`
+
+	Extras Extras `json:"-"`
+}
+
+// Watches holds the watch count of a cast
+type Watches struct {
+	Count int `json:"count"`
+
+	Extras Extras `json:"-"`
+}
+
+// Recasts holds the recast count of a cast and a sample of its recasters
+type Recasts struct {
+	Count     int        `json:"count"`
+	Recasters []Recaster `json:"recasters,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// Recaster represents a user who recast a cast
+type Recaster struct {
+	FID         int    `json:"fid"`
+	Username    string `json:"username,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	RecastHash  string `json:"recastHash"`
+
+	Extras Extras `json:"-"`
+}
+
+// Embeds represents the typed embeds of a cast
+type Embeds struct {
+	Images            []ImageEmbed `json:"images,omitempty"`
+	Urls              []UrlEmbed   `json:"urls,omitempty"`
+	Videos            []VideoEmbed `json:"videos,omitempty"`
+	Casts             []ApiCast    `json:"casts,omitempty"`
+	Unknowns          []UrlEmbed   `json:"unknowns,omitempty"`
+	ProcessedCastText string       `json:"processedCastText"`
+
+	Extras Extras `json:"-"`
+}
+
+// ImageEmbed represents an image embedded in a cast
+type ImageEmbed struct {
+	Type      string `json:"type"`
+	URL       string `json:"url"`
+	SourceURL string `json:"sourceUrl"`
+	Alt       string `json:"alt,omitempty"`
+	Width     *int   `json:"width,omitempty"`
+	Height    *int   `json:"height,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// VideoEmbed represents a video embedded in a cast
+type VideoEmbed struct {
+	Type      string `json:"type"`
+	URL       string `json:"url"`
+	SourceURL string `json:"sourceUrl"`
+	Width     *int   `json:"width,omitempty"`
+	Height    *int   `json:"height,omitempty"`
+	Duration  *int   `json:"duration,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// UrlEmbed represents a link embedded in a cast
+type UrlEmbed struct {
+	Type      string    `json:"type"`
+	OpenGraph OpenGraph `json:"openGraph"`
+
+	Extras Extras `json:"-"`
 }
 
-type Author struct {
-	FID       int    `json:"fid"`
-	Username  string `json:"username"`
-	DisplayName string `json:"displayName"`
-	// Add other author fields as needed
+// OpenGraph holds the OpenGraph metadata of an embedded link
+type OpenGraph struct {
+	URL              string  `json:"url"`
+	SourceURL        string  `json:"sourceUrl"`
+	Title            string  `json:"title,omitempty"`
+	Description      string  `json:"description,omitempty"`
+	Domain           string  `json:"domain,omitempty"`
+	Image            string  `json:"image,omitempty"`
+	Logo             string  `json:"logo,omitempty"`
+	UseLargeImage    bool    `json:"useLargeImage"`
+	StrippedCastText *string `json:"strippedCastText,omitempty"`
+
+	Extras Extras `json:"-"`
 }
 
+// Channel represents the channel a cast was posted in
+type Channel struct {
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	ImageURL string `json:"imageUrl,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// Tag represents a tag attached to a cast, such as its channel
+type Tag struct {
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	ImageURL string `json:"imageUrl,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// CastViewerContext describes how the authenticated user has interacted with
+// a cast
+type CastViewerContext struct {
+	Reacted    bool  `json:"reacted"`
+	Recast     bool  `json:"recast"`
+	Bookmarked *bool `json:"bookmarked,omitempty"`
+	Watched    *bool `json:"watched,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// CastContent wraps a single cast in an API response
+type CastContent struct {
+	Cast ApiCast `json:"cast"`
+}
+
+// Author represents the author of a cast
+type Author = ApiUser
+
 // Parent represents the parent of a cast
 type Parent struct {
 	Hash string `json:"hash"`
@@ -103,6 +384,11 @@ type CastsPostRequest struct {
 	ChannelKey *string  `json:"channelKey,omitempty"`
 }
 
+// CastsDeleteRequest represents the request to delete a cast
+type CastsDeleteRequest struct {
+	CastHash string `json:"castHash"`
+}
+
 // CastsPostResponse represents the response from posting a cast
 type CastsPostResponse struct {
 	Result CastContent `json:"result"`
@@ -110,10 +396,75 @@ type CastsPostResponse struct {
 
 // ApiUser represents a Farcaster user
 type ApiUser struct {
-	FID         int    `json:"fid"`
-	Username    string `json:"username"`
-	DisplayName string `json:"displayName"`
-	// Add other fields as needed
+	FID               int                `json:"fid"`
+	Username          string             `json:"username,omitempty"`
+	DisplayName       string             `json:"displayName,omitempty"`
+	RegisteredAt      *int64             `json:"registeredAt,omitempty"`
+	Pfp               *Pfp               `json:"pfp,omitempty"`
+	Profile           *Profile           `json:"profile,omitempty"`
+	FollowerCount     int                `json:"followerCount"`
+	FollowingCount    int                `json:"followingCount"`
+	ReferrerUsername  *string            `json:"referrerUsername,omitempty"`
+	ActiveOnFcNetwork bool               `json:"activeOnFcNetwork"`
+	Verifications     []string           `json:"verifications,omitempty"`
+	ConnectedAccounts []ConnectedAccount `json:"connectedAccounts,omitempty"`
+	ViewerContext     *UserViewerContext `json:"viewerContext,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// Pfp represents the profile picture of a user
+type Pfp struct {
+	URL      string `json:"url"`
+	Verified bool   `json:"verified"`
+
+	Extras Extras `json:"-"`
+}
+
+// Profile represents the profile of a user
+type Profile struct {
+	Bio      Bio       `json:"bio"`
+	Location *Location `json:"location,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// Bio represents the bio of a user
+type Bio struct {
+	Text            string   `json:"text"`
+	Mentions        []string `json:"mentions"`
+	ChannelMentions []string `json:"channelMentions,omitempty"`
+
+	Extras Extras `json:"-"`
+}
+
+// Location represents the location set on a user's profile
+type Location struct {
+	PlaceID     string `json:"placeId"`
+	Description string `json:"description"`
+
+	Extras Extras `json:"-"`
+}
+
+// ConnectedAccount represents an external account linked to a user
+type ConnectedAccount struct {
+	ConnectedAccountID string `json:"connectedAccountId"`
+	Platform           string `json:"platform"`
+	Username           string `json:"username"`
+
+	Extras Extras `json:"-"`
+}
+
+// UserViewerContext describes the relationship between the authenticated
+// user and another user
+type UserViewerContext struct {
+	Following            bool  `json:"following"`
+	FollowedBy           bool  `json:"followedBy"`
+	CanSendDirectCasts   *bool `json:"canSendDirectCasts,omitempty"`
+	EnableNotifications  *bool `json:"enableNotifications,omitempty"`
+	HasUploadedInboxKeys *bool `json:"hasUploadedInboxKeys,omitempty"`
+
+	Extras Extras `json:"-"`
 }
 
 type UsersResult struct {
@@ -1,6 +1,7 @@
 package farcaster
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -14,11 +15,16 @@ import (
 //   - *StatusContent: Status of the unfollow operation
 //   - error: Any error that occurred
 func (w *Warpcast) UnfollowUser(fid int) (*StatusContent, error) {
+	return w.UnfollowUserContext(context.Background(), fid)
+}
+
+// UnfollowUserContext is like UnfollowUser but uses ctx for the request
+func (w *Warpcast) UnfollowUserContext(ctx context.Context, fid int) (*StatusContent, error) {
 	body := FollowsDeleteRequest{
 		TargetFid: fid,
 	}
 
-	resp, err := w.request("DELETE", "follows", nil, body, nil)
+	resp, err := w.request(ctx, "DELETE", "follows", nil, body, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unfollow user: %w", err)
 	}
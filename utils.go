@@ -1,19 +1,18 @@
-package main
+package farcaster
 
 import (
+	"bufio"
+	"container/list"
+	"errors"
 	"fmt"
-	"log"
-	"math/rand"
-	"time"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
 )
 
-type ApiCast struct{}
-type ApiUser struct{}
-type MentionNotification struct{}
-type ReplyNotification struct{}
-
-type Streamable interface{}
-
+// ExponentialCounter produces the backoff used between polls that found
+// nothing new
 type ExponentialCounter struct {
 	maxCounter int
 	counter    int
@@ -31,118 +30,135 @@ func (e *ExponentialCounter) counterFunction() int {
 	return e.counter
 }
 
+// BoundedSet is a fixed-capacity set that evicts its least recently used
+// attribute when full. Insert and lookup are O(1)
+//
+// A BoundedSet is safe for concurrent use
 type BoundedSet struct {
-	set map[string]bool
+	mu        sync.Mutex
+	maxSize   int
+	order     *list.List // front is most recently used
+	set       map[string]*list.Element
+	evictions int
 }
 
+// NewBoundedSet creates an empty set holding at most size attributes
 func NewBoundedSet(size int) *BoundedSet {
+	if size < 1 {
+		size = 1
+	}
 	return &BoundedSet{
-		set: make(map[string]bool, size),
+		maxSize: size,
+		order:   list.New(),
+		set:     make(map[string]*list.Element, size),
 	}
 }
 
-func (b *BoundedSet) Add(attribute string) {
-	if len(b.set) >= cap(b.set) {
-		// Remove a random element to make space
-		for k := range b.set {
-			delete(b.set, k)
-			break
+// LoadBoundedSet creates a set holding at most size attributes, filled from a
+// file written by Save. A missing file yields an empty set
+func LoadBoundedSet(path string, size int) (*BoundedSet, error) {
+	b := NewBoundedSet(size)
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return b, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bounded set: %w", err)
+	}
+	defer file.Close()
+
+	// Attributes are stored oldest first, so replaying them restores the order
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			b.Add(line)
 		}
 	}
-	b.set[attribute] = true
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read bounded set: %w", err)
+	}
+
+	// Loading is not eviction pressure from the caller's point of view
+	b.evictions = 0
+	return b, nil
 }
 
+// Add inserts attribute, or marks it as most recently used if present
+func (b *BoundedSet) Add(attribute string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if elem, ok := b.set[attribute]; ok {
+		b.order.MoveToFront(elem)
+		return
+	}
+
+	if b.order.Len() >= b.maxSize {
+		oldest := b.order.Back()
+		b.order.Remove(oldest)
+		delete(b.set, oldest.Value.(string))
+		b.evictions++
+	}
+	b.set[attribute] = b.order.PushFront(attribute)
+}
+
+// Contains reports whether attribute is in the set, marking it as most
+// recently used if so
 func (b *BoundedSet) Contains(attribute string) bool {
-	_, found := b.set[attribute]
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	elem, found := b.set[attribute]
+	if found {
+		b.order.MoveToFront(elem)
+	}
 	return found
 }
 
-func streamGenerator(
-	function func(cursor *string, limit int) []Streamable,
-	attributeName string,
-	pauseAfter *int,
-	skipExisting bool,
-	maxCounter int,
-	limit int,
-	cursor *string,
-) <-chan Streamable {
-
-	output := make(chan Streamable)
-	exponentialCounter := &ExponentialCounter{maxCounter: maxCounter}
-	seenAttributes := NewBoundedSet(301)
-	var beforeAttribute string
-	withoutBeforeCounter := 0
-	responsesWithoutNew := 0
-
-	go func() {
-		defer close(output)
-
-		for {
-			found := false
-			var newestAttribute string
-			dynamicLimit := limit
-
-			if beforeAttribute == "" {
-				dynamicLimit -= withoutBeforeCounter
-				withoutBeforeCounter = (withoutBeforeCounter + 1) % (limit / 2)
-			}
-
-			log.Printf("Limit: %d", dynamicLimit)
-
-			items := function(cursor, dynamicLimit)
-			for i := len(items) - 1; i >= 0; i-- {
-				item := items[i]
-				attribute := fmt.Sprintf("%v", item) // Assuming attribute extraction logic here
-				if seenAttributes.Contains(attribute) {
-					continue
-				}
-				found = true
-				seenAttributes.Add(attribute)
-				newestAttribute = attribute
-				if !skipExisting {
-					output <- item
-				}
-			}
-
-			beforeAttribute = newestAttribute
-			skipExisting = false
-
-			if pauseAfter != nil && *pauseAfter < 0 {
-				output <- nil
-			} else if found {
-				exponentialCounter.reset()
-				responsesWithoutNew = 0
-			} else {
-				responsesWithoutNew++
-				if pauseAfter != nil && responsesWithoutNew > *pauseAfter {
-					exponentialCounter.reset()
-					responsesWithoutNew = 0
-					output <- nil
-				} else {
-					time.Sleep(time.Duration(exponentialCounter.counterFunction()) * time.Second)
-				}
-			}
-		}
-	}()
+// Len returns the number of attributes in the set
+func (b *BoundedSet) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.order.Len()
+}
 
-	return output
+// Evictions returns the number of attributes evicted to make room
+func (b *BoundedSet) Evictions() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.evictions
 }
 
-func main() {
-	// Example usage
-	cursor := ""
-	pauseAfter := 5
-	limit := 50
-	maxCounter := 16
-	skipExisting := false
-	attributeName := "hash"
-
-	function := func(cursor *string, limit int) []Streamable {
-		// Simulate function logic that returns a list of items
-		return []Streamable{ApiUser{}, ApiCast{}, MentionNotification{}, ReplyNotification{}}
+// Save writes the set to path, oldest attribute first, replacing the file
+// atomically
+func (b *BoundedSet) Save(path string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create bounded set file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	writer := bufio.NewWriter(tmp)
+	for elem := b.order.Back(); elem != nil; elem = elem.Prev() {
+		if _, err := writer.WriteString(elem.Value.(string) + "\n"); err != nil {
+			tmp.Close()
+			return fmt.Errorf("failed to write bounded set: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write bounded set: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write bounded set: %w", err)
 	}
 
-	for item := range streamGenerator(function, attributeName, &pauseAfter, skipExisting, maxCounter, limit, &cursor) {
-		fmt.Println(item)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace bounded set file: %w", err)
 	}
+	return nil
 }
@@ -0,0 +1,229 @@
+package farcaster
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// DefaultDerivationPath is the BIP-44 path of the first Ethereum account,
+// which is the custody address of a Warpcast recovery phrase
+const DefaultDerivationPath = "m/44'/60'/0'/0/0"
+
+// hardenedOffset is added to an index to derive a hardened child key
+const hardenedOffset = 0x80000000
+
+// LocalAccountFromMnemonic derives the account at m/44'/60'/0'/0/index from a
+// BIP-39 mnemonic
+//
+// Parameters:
+//   - mnemonic: BIP-39 mnemonic, e.g. a Warpcast recovery phrase
+//   - index: Address index of the account, 0 for the custody address
+//
+// Returns:
+//   - *LocalAccount: The derived account with address and public key set
+//   - error: Any error that occurred
+func LocalAccountFromMnemonic(mnemonic string, index uint32) (*LocalAccount, error) {
+	return LocalAccountFromMnemonicPath(mnemonic, fmt.Sprintf("m/44'/60'/0'/0/%d", index))
+}
+
+// LocalAccountFromMnemonicPath derives the account at the given BIP-32 path,
+// e.g. DefaultDerivationPath, from a BIP-39 mnemonic
+//
+// Parameters:
+//   - mnemonic: BIP-39 mnemonic
+//   - path: BIP-32 derivation path, with ' marking hardened indexes
+//
+// Returns:
+//   - *LocalAccount: The derived account with address and public key set
+//   - error: Any error that occurred
+func LocalAccountFromMnemonicPath(mnemonic, path string) (*LocalAccount, error) {
+	// Normalise whitespace so phrases copied from apps still validate
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+
+	indexes, err := parseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := deriveKey(seed, indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	return newLocalAccount(privateKey), nil
+}
+
+// NewWarpcastFromMnemonic creates a client whose access tokens are minted
+// with the custody account of a BIP-39 mnemonic
+//
+// Parameters:
+//   - mnemonic: BIP-39 mnemonic, e.g. a Warpcast recovery phrase
+//   - rotationDuration: Lifetime of each access token, e.g. 10 * time.Minute
+//   - opts: Additional client options
+//
+// Returns:
+//   - *Warpcast: The authenticated client
+//   - error: Any error that occurred
+func NewWarpcastFromMnemonic(mnemonic string, rotationDuration time.Duration, opts ...WarpcastOption) (*Warpcast, error) {
+	account, err := LocalAccountFromMnemonic(mnemonic, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append([]WarpcastOption{WithWallet(account), WithRotationDuration(rotationDuration)}, opts...)
+	return NewWarpcast(opts...)
+}
+
+// NewWarpcastFromPrivateKey creates a client whose access tokens are minted
+// with the given custody private key
+//
+// Parameters:
+//   - privateKey: Hex encoded custody private key, with or without 0x prefix
+//   - opts: Additional client options
+//
+// Returns:
+//   - *Warpcast: The authenticated client
+//   - error: Any error that occurred
+func NewWarpcastFromPrivateKey(privateKey string, opts ...WarpcastOption) (*Warpcast, error) {
+	account, err := LocalAccountFromPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWarpcast(append([]WarpcastOption{WithWallet(account)}, opts...)...)
+}
+
+// LocalAccountFromPrivateKey creates an account from a hex encoded private
+// key, deriving its address and public key
+//
+// Parameters:
+//   - privateKey: Hex encoded private key, with or without 0x prefix
+//
+// Returns:
+//   - *LocalAccount: The account with address and public key set
+//   - error: Any error that occurred
+func LocalAccountFromPrivateKey(privateKey string) (*LocalAccount, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(privateKey), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	return newLocalAccount(key), nil
+}
+
+// validateLocalAccount checks that account's private key parses and that its
+// address and public key, when set, belong to it. The returned copy has both
+// filled in
+func validateLocalAccount(account *LocalAccount) (*LocalAccount, error) {
+	derived, err := LocalAccountFromPrivateKey(account.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Address != "" && !strings.EqualFold(account.Address, derived.Address) {
+		return nil, fmt.Errorf("wallet address %s does not match private key address %s", account.Address, derived.Address)
+	}
+	if account.PublicKey != "" && !strings.EqualFold(strings.TrimPrefix(account.PublicKey, "0x"), strings.TrimPrefix(derived.PublicKey, "0x")) {
+		return nil, fmt.Errorf("wallet public key does not match private key")
+	}
+
+	return derived, nil
+}
+
+// newLocalAccount returns the account for privateKey
+func newLocalAccount(privateKey *ecdsa.PrivateKey) *LocalAccount {
+	return &LocalAccount{
+		PrivateKey: hexutil.Encode(crypto.FromECDSA(privateKey)),
+		PublicKey:  hexutil.Encode(crypto.FromECDSAPub(&privateKey.PublicKey)),
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey).Hex(),
+	}
+}
+
+// parseDerivationPath parses a path such as "m/44'/60'/0'/0/0" into child
+// indexes
+func parseDerivationPath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if len(parts) == 0 || parts[0] != "m" {
+		return nil, fmt.Errorf("invalid derivation path %q: must start with m", path)
+	}
+
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h")
+		part = strings.TrimRight(part, "'h")
+
+		index, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation path %q: %w", path, err)
+		}
+		if hardened {
+			index += hardenedOffset
+		}
+		indexes = append(indexes, uint32(index))
+	}
+
+	return indexes, nil
+}
+
+// deriveKey derives the BIP-32 private key at indexes from seed
+func deriveKey(seed []byte, indexes []uint32) (*ecdsa.PrivateKey, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	key, chainCode := sum[:32], sum[32:]
+	curveOrder := crypto.S256().Params().N
+
+	for _, index := range indexes {
+		var data []byte
+		if index >= hardenedOffset {
+			data = append([]byte{0x00}, key...)
+		} else {
+			parent, err := crypto.ToECDSA(key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to derive key: %w", err)
+			}
+			data = crypto.CompressPubkey(&parent.PublicKey)
+		}
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+
+		tweak := new(big.Int).SetBytes(sum[:32])
+		if tweak.Cmp(curveOrder) >= 0 {
+			return nil, fmt.Errorf("failed to derive key: invalid child at index %d", index)
+		}
+
+		child := tweak.Add(tweak, new(big.Int).SetBytes(key))
+		child.Mod(child, curveOrder)
+		if child.Sign() == 0 {
+			return nil, fmt.Errorf("failed to derive key: invalid child at index %d", index)
+		}
+
+		key = child.FillBytes(make([]byte, 32))
+		chainCode = sum[32:]
+	}
+
+	privateKey, err := crypto.ToECDSA(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+	return privateKey, nil
+}